@@ -120,6 +120,7 @@ val services = mapOf(
     "glue" to ServiceSpec("Glue"),
     "grafana" to ServiceSpec("Managed Grafana"),
     "greengrass" to ServiceSpec("IoT Greengrass"),
+    "greengrassv2" to ServiceSpec("IoT Greengrass V2"),
     "groundstation" to ServiceSpec("Ground Station"),
     "guardduty" to ServiceSpec("GuardDuty"),
     "healthlake" to ServiceSpec("HealthLake"),
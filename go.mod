@@ -134,6 +134,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/glue v1.135.3
 	github.com/aws/aws-sdk-go-v2/service/grafana v1.32.9
 	github.com/aws/aws-sdk-go-v2/service/greengrass v1.32.16
+	github.com/aws/aws-sdk-go-v2/service/greengrassv2 v1.33.4
 	github.com/aws/aws-sdk-go-v2/service/groundstation v1.39.6
 	github.com/aws/aws-sdk-go-v2/service/guardduty v1.72.0
 	github.com/aws/aws-sdk-go-v2/service/healthlake v1.36.8
@@ -125,6 +125,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/glue"
 	"github.com/aws/aws-sdk-go-v2/service/grafana"
 	"github.com/aws/aws-sdk-go-v2/service/greengrass"
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2"
 	"github.com/aws/aws-sdk-go-v2/service/groundstation"
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
 	"github.com/aws/aws-sdk-go-v2/service/healthlake"
@@ -738,6 +739,10 @@ func (c *AWSClient) GreengrassClient(ctx context.Context) *greengrass.Client {
 	return errs.Must(client[*greengrass.Client](ctx, c, names.Greengrass, make(map[string]any)))
 }
 
+func (c *AWSClient) GreengrassV2Client(ctx context.Context) *greengrassv2.Client {
+	return errs.Must(client[*greengrassv2.Client](ctx, c, names.GreengrassV2, make(map[string]any)))
+}
+
 func (c *AWSClient) GroundStationClient(ctx context.Context) *groundstation.Client {
 	return errs.Must(client[*groundstation.Client](ctx, c, names.GroundStation, make(map[string]any)))
 }
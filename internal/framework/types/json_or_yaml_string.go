@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var (
+	_ basetypes.StringTypable = (*jsonOrYAMLStringType)(nil)
+)
+
+type jsonOrYAMLStringType struct {
+	basetypes.StringType
+}
+
+var (
+	JSONOrYAMLStringType = jsonOrYAMLStringType{}
+)
+
+func (t jsonOrYAMLStringType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonOrYAMLStringType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (jsonOrYAMLStringType) String() string {
+	return "JSONOrYAMLStringType"
+}
+
+func (t jsonOrYAMLStringType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return JSONOrYAMLStringNull(), diags
+	}
+	if in.IsUnknown() {
+		return JSONOrYAMLStringUnknown(), diags
+	}
+
+	return JSONOrYAMLStringValue(in.ValueString()), diags
+}
+
+func (t jsonOrYAMLStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (jsonOrYAMLStringType) ValueType(context.Context) attr.Value {
+	return JSONOrYAMLString{}
+}
+
+var (
+	_ basetypes.StringValuable                   = (*JSONOrYAMLString)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*JSONOrYAMLString)(nil)
+)
+
+// JSONOrYAMLString is a string containing a JSON or YAML document.
+// Values are compared using verify.SuppressEquivalentJSONOrYAMLDiffs, so JSON documents that differ only in
+// whitespace or key order and YAML documents that differ only in line endings are semantically equal.
+// Values are not validated, so documents that are not valid JSON or YAML are left for the API to reject.
+type JSONOrYAMLString struct {
+	basetypes.StringValue
+}
+
+func JSONOrYAMLStringNull() JSONOrYAMLString {
+	return JSONOrYAMLString{StringValue: basetypes.NewStringNull()}
+}
+
+func JSONOrYAMLStringUnknown() JSONOrYAMLString {
+	return JSONOrYAMLString{StringValue: basetypes.NewStringUnknown()}
+}
+
+func JSONOrYAMLStringValue(value string) JSONOrYAMLString {
+	return JSONOrYAMLString{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v JSONOrYAMLString) Equal(o attr.Value) bool {
+	other, ok := o.(JSONOrYAMLString)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (JSONOrYAMLString) Type(context.Context) attr.Type {
+	return JSONOrYAMLStringType
+}
+
+func (v JSONOrYAMLString) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONOrYAMLString)
+	if !ok {
+		return false, diags
+	}
+
+	old, d := v.ToStringValue(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	new, d := newValue.ToStringValue(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return verify.SuppressEquivalentJSONOrYAMLDiffs("", old.ValueString(), new.ValueString(), nil), diags
+}
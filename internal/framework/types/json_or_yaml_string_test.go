@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestJSONOrYAMLStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.JSONOrYAMLString
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"identical YAML, equal": {
+			val1:   fwtypes.JSONOrYAMLStringValue("version: \"2\"\n"),
+			val2:   fwtypes.JSONOrYAMLStringValue("version: \"2\"\n"),
+			equals: true,
+		},
+		"YAML line endings, equal": {
+			val1:   fwtypes.JSONOrYAMLStringValue("a: 1\nb: 2\n"),
+			val2:   fwtypes.JSONOrYAMLStringValue("a: 1\r\nb: 2\r\n"),
+			equals: true,
+		},
+		"JSON whitespace, equal": {
+			val1:   fwtypes.JSONOrYAMLStringValue(`{"a":1,"b":[1,2]}`),
+			val2:   fwtypes.JSONOrYAMLStringValue(`{ "b": [1, 2], "a": 1 }`),
+			equals: true,
+		},
+		"YAML value, not equal": {
+			val1:   fwtypes.JSONOrYAMLStringValue("a: 1\n"),
+			val2:   fwtypes.JSONOrYAMLStringValue("a: 2\n"),
+			equals: false,
+		},
+		"invalid, not equal": {
+			val1:   fwtypes.JSONOrYAMLStringValue(`{"a":`),
+			val2:   fwtypes.JSONOrYAMLStringValue(`{"a":`),
+			equals: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
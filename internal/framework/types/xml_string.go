@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*xmlStringType)(nil)
+)
+
+type xmlStringType struct {
+	basetypes.StringType
+}
+
+var (
+	XMLStringType = xmlStringType{}
+)
+
+func (t xmlStringType) Equal(o attr.Type) bool {
+	other, ok := o.(xmlStringType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (xmlStringType) String() string {
+	return "XMLStringType"
+}
+
+func (t xmlStringType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return XMLStringNull(), diags
+	}
+	if in.IsUnknown() {
+		return XMLStringUnknown(), diags
+	}
+
+	return XMLStringValue(in.ValueString()), diags
+}
+
+func (t xmlStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (xmlStringType) ValueType(context.Context) attr.Value {
+	return XMLString{}
+}
+
+var (
+	_ basetypes.StringValuable                   = (*XMLString)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*XMLString)(nil)
+	_ xattr.ValidateableAttribute                = (*XMLString)(nil)
+)
+
+// XMLString is a string containing an XML document.
+// Documents that differ only in insignificant whitespace, comments or attribute order are semantically equal.
+type XMLString struct {
+	basetypes.StringValue
+}
+
+func XMLStringNull() XMLString {
+	return XMLString{StringValue: basetypes.NewStringNull()}
+}
+
+func XMLStringUnknown() XMLString {
+	return XMLString{StringValue: basetypes.NewStringUnknown()}
+}
+
+func XMLStringValue(value string) XMLString {
+	return XMLString{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v XMLString) Equal(o attr.Value) bool {
+	other, ok := o.(XMLString)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (XMLString) Type(context.Context) attr.Type {
+	return XMLStringType
+}
+
+func (v XMLString) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(XMLString)
+	if !ok {
+		return false, diags
+	}
+
+	old, d := v.ToStringValue(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	new, d := newValue.ToStringValue(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	oldNormalized, err := normalizeXML(old.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	newNormalized, err := normalizeXML(new.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return oldNormalized == newNormalized, diags
+}
+
+func (v XMLString) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := normalizeXML(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid XML String Value",
+			"A string value was provided that is not valid XML.\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+// normalizeXML returns a canonical representation of the specified XML document's token stream.
+func normalizeXML(s string) (string, error) {
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	var sb strings.Builder
+	depth, elements := 0, 0
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch v := token.(type) {
+		case xml.StartElement:
+			depth++
+			elements++
+			attrs := make([]string, 0, len(v.Attr))
+			for _, a := range v.Attr {
+				attrs = append(attrs, fmt.Sprintf("%s:%s=%q", a.Name.Space, a.Name.Local, a.Value))
+			}
+			slices.Sort(attrs)
+			fmt.Fprintf(&sb, "<%s:%s %s>", v.Name.Space, v.Name.Local, strings.Join(attrs, " "))
+		case xml.EndElement:
+			depth--
+			fmt.Fprintf(&sb, "</%s:%s>", v.Name.Space, v.Name.Local)
+		case xml.CharData:
+			if text := strings.TrimSpace(string(v)); text != "" {
+				fmt.Fprintf(&sb, "%q", text)
+			}
+		case xml.ProcInst:
+			// The XML declaration is not significant.
+			if v.Target != "xml" {
+				fmt.Fprintf(&sb, "<?%s %s?>", v.Target, strings.TrimSpace(string(v.Inst)))
+			}
+		}
+	}
+
+	if depth != 0 || elements == 0 {
+		return "", errors.New("incomplete XML document")
+	}
+
+	return sb.String(), nil
+}
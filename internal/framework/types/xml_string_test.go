@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestXMLStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.XMLString
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"identical, equal": {
+			val1:   fwtypes.XMLStringValue(`<a><b>x</b></a>`),
+			val2:   fwtypes.XMLStringValue(`<a><b>x</b></a>`),
+			equals: true,
+		},
+		"whitespace, equal": {
+			val1: fwtypes.XMLStringValue(`<a><b>x</b></a>`),
+			val2: fwtypes.XMLStringValue(`
+<a>
+  <b> x </b>
+</a>
+`),
+			equals: true,
+		},
+		"declaration, equal": {
+			val1:   fwtypes.XMLStringValue(`<?xml version="1.0" encoding="UTF-8"?><a/>`),
+			val2:   fwtypes.XMLStringValue(`<a></a>`),
+			equals: true,
+		},
+		"comment, equal": {
+			val1:   fwtypes.XMLStringValue(`<a><!-- comment --><b/></a>`),
+			val2:   fwtypes.XMLStringValue(`<a><b/></a>`),
+			equals: true,
+		},
+		"attribute order, equal": {
+			val1:   fwtypes.XMLStringValue(`<a x="1" y="2"/>`),
+			val2:   fwtypes.XMLStringValue(`<a y="2" x="1"/>`),
+			equals: true,
+		},
+		"text, not equal": {
+			val1:   fwtypes.XMLStringValue(`<a><b>x</b></a>`),
+			val2:   fwtypes.XMLStringValue(`<a><b>y</b></a>`),
+			equals: false,
+		},
+		"attribute value, not equal": {
+			val1:   fwtypes.XMLStringValue(`<a x="1"/>`),
+			val2:   fwtypes.XMLStringValue(`<a x="2"/>`),
+			equals: false,
+		},
+		"namespace, not equal": {
+			val1:   fwtypes.XMLStringValue(`<a xmlns="urn:one"/>`),
+			val2:   fwtypes.XMLStringValue(`<a xmlns="urn:two"/>`),
+			equals: false,
+		},
+		"invalid, not equal": {
+			val1:   fwtypes.XMLStringValue(`<a>`),
+			val2:   fwtypes.XMLStringValue(`<a>`),
+			equals: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
+
+func TestXMLStringValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         fwtypes.XMLString
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"null value": {
+			val: fwtypes.XMLStringNull(),
+		},
+		"unknown value": {
+			val: fwtypes.XMLStringUnknown(),
+		},
+		"valid XML": {
+			val: fwtypes.XMLStringValue(`<?xml version="1.0"?><a><b>x</b></a>`),
+		},
+		"empty": {
+			val:         fwtypes.XMLStringValue(""),
+			expectError: true,
+		},
+		"not XML": {
+			val:         fwtypes.XMLStringValue("not ok"),
+			expectError: true,
+		},
+		"unclosed element": {
+			val:         fwtypes.XMLStringValue("<a><b></a>"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req := xattr.ValidateAttributeRequest{}
+			resp := xattr.ValidateAttributeResponse{}
+
+			test.val.ValidateAttribute(ctx, req, &resp)
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("resp.Diagnostics.HasError() = %t, want = %t", resp.Diagnostics.HasError(), test.expectError)
+			}
+		})
+	}
+}
@@ -957,6 +957,13 @@ func endpointsBlock() schema.SetNestedBlock {
 					Description: "Use this to override the default service endpoint URL",
 				},
 
+				// greengrassv2
+
+				"greengrassv2": schema.StringAttribute{
+					Optional:    true,
+					Description: "Use this to override the default service endpoint URL",
+				},
+
 				// groundstation
 
 				"groundstation": schema.StringAttribute{
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationinsights"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfapplicationinsights "github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationInsightsComponentConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var configuration applicationinsights.DescribeComponentConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_component_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentConfigurationConfig_basic(rName, "CPUUtilization"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentConfigurationExists(ctx, resourceName, &configuration),
+					resource.TestCheckResourceAttrSet(resourceName, "component_configuration"),
+					resource.TestCheckResourceAttr(resourceName, "component_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "resource_group_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tier", "DEFAULT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComponentConfigurationConfig_basic(rName, "StatusCheckFailed"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentConfigurationExists(ctx, resourceName, &configuration),
+					resource.TestCheckResourceAttrSet(resourceName, "component_configuration"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationInsightsComponentConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var configuration applicationinsights.DescribeComponentConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_component_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentConfigurationConfig_basic(rName, "CPUUtilization"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentConfigurationExists(ctx, resourceName, &configuration),
+					acctest.CheckSDKResourceDisappears(ctx, t, tfapplicationinsights.ResourceComponentConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComponentConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_applicationinsights_component_configuration" {
+				continue
+			}
+
+			_, err := tfapplicationinsights.FindComponentConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_group_name"], rs.Primary.Attributes["component_name"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ApplicationInsights Component Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComponentConfigurationExists(ctx context.Context, n string, v *applicationinsights.DescribeComponentConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+		output, err := tfapplicationinsights.FindComponentConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_group_name"], rs.Primary.Attributes["component_name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccComponentConfigurationConfig_basic(rName, alarmMetricName string) string {
+	return acctest.ConfigCompose(testAccComponentConfig_basic(rName, 2), fmt.Sprintf(`
+resource "aws_applicationinsights_component_configuration" "test" {
+  resource_group_name = aws_applicationinsights_component.test.resource_group_name
+  component_name      = aws_applicationinsights_component.test.component_name
+  tier                = "DEFAULT"
+
+  component_configuration = jsonencode({
+    alarmMetrics = [{
+      alarmMetricName = %[1]q
+      monitor         = true
+    }]
+  })
+}
+`, alarmMetricName))
+}
@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationinsights"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfapplicationinsights "github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationInsightsComponent_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var component applicationinsights.DescribeComponentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_component.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentExists(ctx, resourceName, &component),
+					resource.TestCheckResourceAttr(resourceName, "component_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "resource_group_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "resource_list.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComponentConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentExists(ctx, resourceName, &component),
+					resource.TestCheckResourceAttr(resourceName, "resource_list.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationInsightsComponent_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var component applicationinsights.DescribeComponentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_component.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentExists(ctx, resourceName, &component),
+					acctest.CheckSDKResourceDisappears(ctx, t, tfapplicationinsights.ResourceComponent(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComponentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_applicationinsights_component" {
+				continue
+			}
+
+			_, err := tfapplicationinsights.FindComponentByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_group_name"], rs.Primary.Attributes["component_name"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ApplicationInsights Component %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComponentExists(ctx context.Context, n string, v *applicationinsights.DescribeComponentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+		output, err := tfapplicationinsights.FindComponentByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_group_name"], rs.Primary.Attributes["component_name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccComponentConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		acctest.ConfigVPCWithSubnets(rName, 1),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  count = 2
+
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_resourcegroups_group" "test" {
+  name = %[1]q
+
+  resource_query {
+    query = jsonencode({
+      ResourceTypeFilters = ["AWS::EC2::Instance"]
+      TagFilters = [{
+        Key    = "Name"
+        Values = [%[1]q]
+      }]
+    })
+  }
+}
+
+resource "aws_applicationinsights_application" "test" {
+  resource_group_name = aws_resourcegroups_group.test.name
+
+  depends_on = [aws_instance.test]
+}
+`, rName))
+}
+
+func testAccComponentConfig_basic(rName string, instanceCount int) string {
+	return acctest.ConfigCompose(testAccComponentConfig_base(rName), fmt.Sprintf(`
+resource "aws_applicationinsights_component" "test" {
+  resource_group_name = aws_applicationinsights_application.test.resource_group_name
+  component_name      = %[1]q
+  resource_list       = slice(aws_instance.test[*].arn, 0, %[2]d)
+}
+`, rName, instanceCount))
+}
@@ -5,7 +5,15 @@ package applicationinsights
 
 // Exports for use in tests only.
 var (
-	ResourceApplication = resourceApplication
+	ResourceApplication            = resourceApplication
+	ResourceComponent              = resourceComponent
+	ResourceComponentConfiguration = resourceComponentConfiguration
+	ResourceLogPattern             = resourceLogPattern
+	ResourceWorkload               = resourceWorkload
 
-	FindApplicationByName = findApplicationByName
+	FindApplicationByName                  = findApplicationByName
+	FindComponentByTwoPartKey              = findComponentByTwoPartKey
+	FindComponentConfigurationByTwoPartKey = findComponentConfigurationByTwoPartKey
+	FindLogPatternByThreePartKey           = findLogPatternByThreePartKey
+	FindWorkloadByThreePartKey             = findWorkloadByThreePartKey
 )
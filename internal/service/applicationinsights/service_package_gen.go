@@ -43,6 +43,30 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  resourceComponent,
+			TypeName: "aws_applicationinsights_component",
+			Name:     "Component",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  resourceComponentConfiguration,
+			TypeName: "aws_applicationinsights_component_configuration",
+			Name:     "Component Configuration",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  resourceLogPattern,
+			TypeName: "aws_applicationinsights_log_pattern",
+			Name:     "Log Pattern",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  resourceWorkload,
+			TypeName: "aws_applicationinsights_workload",
+			Name:     "Workload",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationinsights"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfapplicationinsights "github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationInsightsWorkload_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Workloads require a monitored component running a supported workload, e.g. SQL Server.
+	rgName := acctest.SkipIfEnvVarNotSet(t, "AWS_APPLICATIONINSIGHTS_SQL_SERVER_RESOURCE_GROUP_NAME")
+	componentName := acctest.SkipIfEnvVarNotSet(t, "AWS_APPLICATIONINSIGHTS_SQL_SERVER_COMPONENT_NAME")
+	var workload applicationinsights.DescribeWorkloadOutput
+	resourceName := "aws_applicationinsights_workload.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_basic(rgName, componentName, "CPUUtilization"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName, &workload),
+					resource.TestCheckResourceAttr(resourceName, "component_name", componentName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrConfiguration),
+					resource.TestCheckResourceAttr(resourceName, "resource_group_name", rgName),
+					resource.TestCheckResourceAttr(resourceName, "tier", "SQL_SERVER"),
+					resource.TestCheckResourceAttrSet(resourceName, "workload_id"),
+					resource.TestCheckResourceAttr(resourceName, "workload_name", "tfacc"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkloadConfig_basic(rgName, componentName, "StatusCheckFailed"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName, &workload),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrConfiguration),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkloadDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_applicationinsights_workload" {
+				continue
+			}
+
+			_, err := tfapplicationinsights.FindWorkloadByThreePartKey(ctx, conn, rs.Primary.Attributes["resource_group_name"], rs.Primary.Attributes["component_name"], rs.Primary.Attributes["workload_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ApplicationInsights Workload %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWorkloadExists(ctx context.Context, n string, v *applicationinsights.DescribeWorkloadOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+		output, err := tfapplicationinsights.FindWorkloadByThreePartKey(ctx, conn, rs.Primary.Attributes["resource_group_name"], rs.Primary.Attributes["component_name"], rs.Primary.Attributes["workload_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccWorkloadConfig_basic(rgName, componentName, alarmMetricName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationinsights_workload" "test" {
+  resource_group_name = %[1]q
+  component_name      = %[2]q
+  workload_name       = "tfacc"
+  tier                = "SQL_SERVER"
+
+  configuration = jsonencode({
+    alarmMetrics = [{
+      alarmMetricName = %[3]q
+      monitor         = true
+    }]
+  })
+}
+`, rgName, componentName, alarmMetricName)
+}
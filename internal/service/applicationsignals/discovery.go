@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_applicationsignals_discovery", name="Discovery")
+func newDiscoveryResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &discoveryResource{}, nil
+}
+
+type discoveryResource struct {
+	framework.ResourceWithModel[discoveryResourceModel]
+	framework.WithNoOpRead
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+}
+
+func (r *discoveryResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (r *discoveryResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data discoveryResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	accountID := r.Meta().AccountID(ctx)
+	var input applicationsignals.StartDiscoveryInput
+	_, err := conn.StartDiscovery(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, accountID)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *discoveryResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		response.Diagnostics.AddWarning(
+			"Resource Destruction Considerations",
+			"Application Signals discovery cannot be disabled through the API. Applying this resource destruction "+
+				"will only remove the resource from the Terraform state.",
+		)
+	}
+}
+
+type discoveryResourceModel struct {
+	framework.WithRegionModel
+	ID types.String `tfsdk:"id"`
+}
@@ -0,0 +1,947 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"errors"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_applicationsignals_service_level_objective", name="Service Level Objective")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newServiceLevelObjectiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &serviceLevelObjectiveResource{}, nil
+}
+
+type serviceLevelObjectiveResource struct {
+	framework.ResourceWithModel[serviceLevelObjectiveResourceModel]
+	framework.WithImportByARN
+}
+
+func (r *serviceLevelObjectiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	durationBlock := func() schema.NestedBlockObject {
+		return schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrDuration: schema.Int32Attribute{
+					Required: true,
+				},
+				"duration_unit": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.DurationUnit](),
+					Required:   true,
+				},
+			},
+		}
+	}
+	dependencyConfigBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[dependencyConfigModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"dependency_key_attributes": schema.MapAttribute{
+					CustomType:  fwtypes.MapOfStringType,
+					Required:    true,
+					ElementType: types.StringType,
+				},
+				"dependency_operation_name": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+	metricDataQueryBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[metricDataQueryModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrAccountID: schema.StringAttribute{
+						Optional: true,
+					},
+					names.AttrExpression: schema.StringAttribute{
+						Optional: true,
+					},
+					names.AttrID: schema.StringAttribute{
+						Required: true,
+					},
+					"label": schema.StringAttribute{
+						Optional: true,
+					},
+					"period": schema.Int32Attribute{
+						Optional: true,
+					},
+					"return_data": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"metric_stat": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[metricStatModel](ctx),
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"period": schema.Int32Attribute{
+									Required: true,
+								},
+								"stat": schema.StringAttribute{
+									Required: true,
+								},
+								names.AttrUnit: schema.StringAttribute{
+									CustomType: fwtypes.StringEnumType[awstypes.StandardUnit](),
+									Optional:   true,
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"metric": schema.ListNestedBlock{
+									CustomType: fwtypes.NewListNestedObjectTypeOf[metricModel](ctx),
+									Validators: []validator.List{
+										listvalidator.IsRequired(),
+										listvalidator.SizeAtMost(1),
+									},
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											names.AttrMetricName: schema.StringAttribute{
+												Optional: true,
+											},
+											names.AttrNamespace: schema.StringAttribute{
+												Optional: true,
+											},
+										},
+										Blocks: map[string]schema.Block{
+											"dimension": schema.ListNestedBlock{
+												CustomType: fwtypes.NewListNestedObjectTypeOf[dimensionModel](ctx),
+												NestedObject: schema.NestedBlockObject{
+													Attributes: map[string]schema.Attribute{
+														names.AttrName: schema.StringAttribute{
+															Required: true,
+														},
+														names.AttrValue: schema.StringAttribute{
+															Required: true,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			"evaluation_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvaluationType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"metric_source_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MetricSourceType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"burn_rate_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[burnRateConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"look_back_window_minutes": schema.Int32Attribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"exclusion_window": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[exclusionWindowModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"reason": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrStartTime: schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Optional:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"recurrence_rule": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recurrenceRuleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrExpression: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"window": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[windowModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: durationBlock(),
+						},
+					},
+				},
+			},
+			"goal": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[goalModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"attainment_goal": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+						},
+						"warning_threshold": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrInterval: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[intervalModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"calendar_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[calendarIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("calendar_interval"),
+												path.MatchRelative().AtParent().AtName("rolling_interval"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrDuration: schema.Int32Attribute{
+													Required: true,
+												},
+												"duration_unit": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.DurationUnit](),
+													Required:   true,
+												},
+												names.AttrStartTime: schema.StringAttribute{
+													CustomType: timetypes.RFC3339Type{},
+													Required:   true,
+												},
+											},
+										},
+									},
+									"rolling_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[rollingIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: durationBlock(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"request_based_sli_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedServiceLevelIndicatorConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.ExactlyOneOf(
+						path.MatchRoot("request_based_sli_config"),
+						path.MatchRoot("sli_config"),
+					),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorComparisonOperator](),
+							Optional:   true,
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"request_based_sli_metric_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedServiceLevelIndicatorMetricConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										Optional:    true,
+										ElementType: types.StringType,
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorMetricType](),
+										Optional:   true,
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"dependency_config": dependencyConfigBlock,
+									"monitored_request_count_metric": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[monitoredRequestCountMetricModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"bad_count_metric":  metricDataQueryBlock(),
+												"good_count_metric": metricDataQueryBlock(),
+											},
+										},
+									},
+									"total_request_count_metric": metricDataQueryBlock(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"sli_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[serviceLevelIndicatorConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorComparisonOperator](),
+							Required:   true,
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"sli_metric_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[serviceLevelIndicatorMetricConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										Optional:    true,
+										ElementType: types.StringType,
+									},
+									names.AttrMetricName: schema.StringAttribute{
+										Optional: true,
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorMetricType](),
+										Optional:   true,
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"period_seconds": schema.Int32Attribute{
+										Optional: true,
+									},
+									"statistic": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"dependency_config": dependencyConfigBlock,
+									"metric_data_query": metricDataQueryBlock(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *serviceLevelObjectiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data serviceLevelObjectiveResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	name := data.Name.ValueString()
+	var input applicationsignals.CreateServiceLevelObjectiveInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateServiceLevelObjective(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	arn := aws.ToString(output.Slo.Arn)
+
+	if !data.ExclusionWindows.IsNull() {
+		var windows []awstypes.ExclusionWindow
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data.ExclusionWindows, &windows))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateExclusionWindows(ctx, conn, arn, windows, nil); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint.
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output.Slo, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *serviceLevelObjectiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data serviceLevelObjectiveResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	arn := data.ARN.ValueString()
+	output, err := findServiceLevelObjectiveByID(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	// The SLI configurations are not returned by the API in the shape they are written,
+	// so they are left as-is in state.
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	windows, err := findExclusionWindowsByID(ctx, conn, arn)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, windows, &data.ExclusionWindows))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *serviceLevelObjectiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old serviceLevelObjectiveResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	arn := new.ARN.ValueString()
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("ExclusionWindows"))
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input applicationsignals.UpdateServiceLevelObjectiveInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.Id = aws.String(arn)
+
+		output, err := conn.UpdateServiceLevelObjective(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		// Set values for unknowns.
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output.Slo, &new))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !new.ExclusionWindows.Equal(old.ExclusionWindows) {
+		var add, remove []awstypes.ExclusionWindow
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new.ExclusionWindows, &add))
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, old.ExclusionWindows, &remove))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateExclusionWindows(ctx, conn, arn, add, remove); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *serviceLevelObjectiveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data serviceLevelObjectiveResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	arn := data.ARN.ValueString()
+	input := applicationsignals.DeleteServiceLevelObjectiveInput{
+		Id: aws.String(arn),
+	}
+	_, err := conn.DeleteServiceLevelObjective(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+}
+
+// updateExclusionWindows removes and then adds exclusion windows on the specified SLO.
+func updateExclusionWindows(ctx context.Context, conn *applicationsignals.Client, id string, add, remove []awstypes.ExclusionWindow) error {
+	batches := []applicationsignals.BatchUpdateExclusionWindowsInput{
+		{RemoveExclusionWindows: remove},
+		{AddExclusionWindows: add},
+	}
+
+	for _, input := range batches {
+		if len(input.AddExclusionWindows) == 0 && len(input.RemoveExclusionWindows) == 0 {
+			continue
+		}
+
+		input.SloIds = []string{id}
+
+		output, err := conn.BatchUpdateExclusionWindows(ctx, &input)
+
+		if err == nil && output != nil && len(output.Errors) > 0 {
+			var batchErrs []error
+			for _, v := range output.Errors {
+				batchErrs = append(batchErrs, errors.New(aws.ToString(v.ErrorMessage)))
+			}
+			err = errors.Join(batchErrs...)
+		}
+
+		if err != nil {
+			return smarterr.NewError(err)
+		}
+	}
+
+	return nil
+}
+
+func findServiceLevelObjectiveByID(ctx context.Context, conn *applicationsignals.Client, id string) (*awstypes.ServiceLevelObjective, error) {
+	input := applicationsignals.GetServiceLevelObjectiveInput{
+		Id: aws.String(id),
+	}
+	output, err := conn.GetServiceLevelObjective(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Slo == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	return output.Slo, nil
+}
+
+func findExclusionWindowsByID(ctx context.Context, conn *applicationsignals.Client, id string) ([]awstypes.ExclusionWindow, error) {
+	input := applicationsignals.ListServiceLevelObjectiveExclusionWindowsInput{
+		Id: aws.String(id),
+	}
+	var output []awstypes.ExclusionWindow
+
+	pages := applicationsignals.NewListServiceLevelObjectiveExclusionWindowsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, smarterr.NewError(&retry.NotFoundError{
+				LastError: err,
+			})
+		}
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		output = append(output, page.ExclusionWindows...)
+	}
+
+	return output, nil
+}
+
+type serviceLevelObjectiveResourceModel struct {
+	framework.WithRegionModel
+	ARN                    types.String                                                                  `tfsdk:"arn"`
+	BurnRateConfigurations fwtypes.ListNestedObjectValueOf[burnRateConfigurationModel]                   `tfsdk:"burn_rate_configuration"`
+	Description            types.String                                                                  `tfsdk:"description"`
+	EvaluationType         fwtypes.StringEnum[awstypes.EvaluationType]                                   `tfsdk:"evaluation_type"`
+	ExclusionWindows       fwtypes.SetNestedObjectValueOf[exclusionWindowModel]                          `tfsdk:"exclusion_window"`
+	Goal                   fwtypes.ListNestedObjectValueOf[goalModel]                                    `tfsdk:"goal"`
+	MetricSourceType       fwtypes.StringEnum[awstypes.MetricSourceType]                                 `tfsdk:"metric_source_type"`
+	Name                   types.String                                                                  `tfsdk:"name"`
+	RequestBasedSliConfig  fwtypes.ListNestedObjectValueOf[requestBasedServiceLevelIndicatorConfigModel] `tfsdk:"request_based_sli_config"`
+	SliConfig              fwtypes.ListNestedObjectValueOf[serviceLevelIndicatorConfigModel]             `tfsdk:"sli_config"`
+	Tags                   tftags.Map                                                                    `tfsdk:"tags"`
+	TagsAll                tftags.Map                                                                    `tfsdk:"tags_all"`
+}
+
+type burnRateConfigurationModel struct {
+	LookBackWindowMinutes types.Int32 `tfsdk:"look_back_window_minutes"`
+}
+
+type exclusionWindowModel struct {
+	Reason         types.String                                         `tfsdk:"reason"`
+	RecurrenceRule fwtypes.ListNestedObjectValueOf[recurrenceRuleModel] `tfsdk:"recurrence_rule"`
+	StartTime      timetypes.RFC3339                                    `tfsdk:"start_time"`
+	Window         fwtypes.ListNestedObjectValueOf[windowModel]         `tfsdk:"window"`
+}
+
+type recurrenceRuleModel struct {
+	Expression types.String `tfsdk:"expression"`
+}
+
+type windowModel struct {
+	Duration     types.Int32                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+}
+
+type goalModel struct {
+	AttainmentGoal   types.Float64                                  `tfsdk:"attainment_goal"`
+	Interval         fwtypes.ListNestedObjectValueOf[intervalModel] `tfsdk:"interval"`
+	WarningThreshold types.Float64                                  `tfsdk:"warning_threshold"`
+}
+
+type intervalModel struct {
+	CalendarInterval fwtypes.ListNestedObjectValueOf[calendarIntervalModel] `tfsdk:"calendar_interval"`
+	RollingInterval  fwtypes.ListNestedObjectValueOf[rollingIntervalModel]  `tfsdk:"rolling_interval"`
+}
+
+var (
+	_ fwflex.Expander  = intervalModel{}
+	_ fwflex.Flattener = &intervalModel{}
+)
+
+func (m intervalModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.CalendarInterval.IsNull():
+		calendarIntervalData, d := m.CalendarInterval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.IntervalMemberCalendarInterval
+		diags.Append(fwflex.Expand(ctx, calendarIntervalData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.RollingInterval.IsNull():
+		rollingIntervalData, d := m.RollingInterval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.IntervalMemberRollingInterval
+		diags.Append(fwflex.Expand(ctx, rollingIntervalData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *intervalModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.IntervalMemberCalendarInterval:
+		var model calendarIntervalModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.CalendarInterval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+		m.RollingInterval = fwtypes.NewListNestedObjectValueOfNull[rollingIntervalModel](ctx)
+
+		return diags
+
+	case awstypes.IntervalMemberRollingInterval:
+		var model rollingIntervalModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.CalendarInterval = fwtypes.NewListNestedObjectValueOfNull[calendarIntervalModel](ctx)
+		m.RollingInterval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type calendarIntervalModel struct {
+	Duration     types.Int32                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+	StartTime    timetypes.RFC3339                         `tfsdk:"start_time"`
+}
+
+type rollingIntervalModel struct {
+	Duration     types.Int32                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+}
+
+type serviceLevelIndicatorConfigModel struct {
+	ComparisonOperator fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator]    `tfsdk:"comparison_operator"`
+	MetricThreshold    types.Float64                                                           `tfsdk:"metric_threshold"`
+	SliMetricConfig    fwtypes.ListNestedObjectValueOf[serviceLevelIndicatorMetricConfigModel] `tfsdk:"sli_metric_config"`
+}
+
+type serviceLevelIndicatorMetricConfigModel struct {
+	DependencyConfig  fwtypes.ListNestedObjectValueOf[dependencyConfigModel]       `tfsdk:"dependency_config"`
+	KeyAttributes     fwtypes.MapOfString                                          `tfsdk:"key_attributes"`
+	MetricDataQueries fwtypes.ListNestedObjectValueOf[metricDataQueryModel]        `tfsdk:"metric_data_query"`
+	MetricName        types.String                                                 `tfsdk:"metric_name"`
+	MetricType        fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType] `tfsdk:"metric_type"`
+	OperationName     types.String                                                 `tfsdk:"operation_name"`
+	PeriodSeconds     types.Int32                                                  `tfsdk:"period_seconds"`
+	Statistic         types.String                                                 `tfsdk:"statistic"`
+}
+
+type requestBasedServiceLevelIndicatorConfigModel struct {
+	ComparisonOperator          fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator]                `tfsdk:"comparison_operator"`
+	MetricThreshold             types.Float64                                                                       `tfsdk:"metric_threshold"`
+	RequestBasedSliMetricConfig fwtypes.ListNestedObjectValueOf[requestBasedServiceLevelIndicatorMetricConfigModel] `tfsdk:"request_based_sli_metric_config"`
+}
+
+type requestBasedServiceLevelIndicatorMetricConfigModel struct {
+	DependencyConfig            fwtypes.ListNestedObjectValueOf[dependencyConfigModel]            `tfsdk:"dependency_config"`
+	KeyAttributes               fwtypes.MapOfString                                               `tfsdk:"key_attributes"`
+	MetricType                  fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType]      `tfsdk:"metric_type"`
+	MonitoredRequestCountMetric fwtypes.ListNestedObjectValueOf[monitoredRequestCountMetricModel] `tfsdk:"monitored_request_count_metric"`
+	OperationName               types.String                                                      `tfsdk:"operation_name"`
+	TotalRequestCountMetric     fwtypes.ListNestedObjectValueOf[metricDataQueryModel]             `tfsdk:"total_request_count_metric"`
+}
+
+type monitoredRequestCountMetricModel struct {
+	BadCountMetric  fwtypes.ListNestedObjectValueOf[metricDataQueryModel] `tfsdk:"bad_count_metric"`
+	GoodCountMetric fwtypes.ListNestedObjectValueOf[metricDataQueryModel] `tfsdk:"good_count_metric"`
+}
+
+var (
+	_ fwflex.Expander  = monitoredRequestCountMetricModel{}
+	_ fwflex.Flattener = &monitoredRequestCountMetricModel{}
+)
+
+func (m monitoredRequestCountMetricModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.BadCountMetric.IsNull():
+		var r awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric
+		diags.Append(fwflex.Expand(ctx, m.BadCountMetric, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.GoodCountMetric.IsNull():
+		var r awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric
+		diags.Append(fwflex.Expand(ctx, m.GoodCountMetric, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *monitoredRequestCountMetricModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric:
+		diags.Append(fwflex.Flatten(ctx, t.Value, &m.BadCountMetric)...)
+		m.GoodCountMetric = fwtypes.NewListNestedObjectValueOfNull[metricDataQueryModel](ctx)
+
+		return diags
+
+	case awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric:
+		m.BadCountMetric = fwtypes.NewListNestedObjectValueOfNull[metricDataQueryModel](ctx)
+		diags.Append(fwflex.Flatten(ctx, t.Value, &m.GoodCountMetric)...)
+
+		return diags
+	}
+
+	return diags
+}
+
+type dependencyConfigModel struct {
+	DependencyKeyAttributes fwtypes.MapOfString `tfsdk:"dependency_key_attributes"`
+	DependencyOperationName types.String        `tfsdk:"dependency_operation_name"`
+}
+
+type metricDataQueryModel struct {
+	AccountID  types.String                                     `tfsdk:"account_id"`
+	Expression types.String                                     `tfsdk:"expression"`
+	ID         types.String                                     `tfsdk:"id"`
+	Label      types.String                                     `tfsdk:"label"`
+	MetricStat fwtypes.ListNestedObjectValueOf[metricStatModel] `tfsdk:"metric_stat"`
+	Period     types.Int32                                      `tfsdk:"period"`
+	ReturnData types.Bool                                       `tfsdk:"return_data"`
+}
+
+type metricStatModel struct {
+	Metric fwtypes.ListNestedObjectValueOf[metricModel] `tfsdk:"metric"`
+	Period types.Int32                                  `tfsdk:"period"`
+	Stat   types.String                                 `tfsdk:"stat"`
+	Unit   fwtypes.StringEnum[awstypes.StandardUnit]    `tfsdk:"unit"`
+}
+
+type metricModel struct {
+	Dimensions fwtypes.ListNestedObjectValueOf[dimensionModel] `tfsdk:"dimension"`
+	MetricName types.String                                    `tfsdk:"metric_name"`
+	Namespace  types.String                                    `tfsdk:"namespace"`
+}
+
+type dimensionModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
@@ -5,6 +5,8 @@ package docdbelastic
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -68,21 +71,25 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 	s := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"admin_user_name": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"admin_user_password": schema.StringAttribute{
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
 			},
 			names.AttrARN: framework.ARNAttributeComputedOnly(),
 			"auth_type": schema.StringAttribute{
 				CustomType: fwtypes.StringEnumType[awstypes.Auth](),
-				Required:   true,
+				Optional:   true,
+				Computed:   true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"backup_retention_period": schema.Int32Attribute{
@@ -140,6 +147,13 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 					int64validator.Between(1, 32),
 				},
 			},
+			"snapshot_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			names.AttrSubnetIDs: schema.SetAttribute{
 				CustomType: fwtypes.SetOfStringType,
 				Optional:   true,
@@ -173,6 +187,38 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 	response.Schema = s
 }
 
+func (r *clusterResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config clusterResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The administrator credentials of a restored cluster come from the snapshot.
+	if !config.SnapshotARN.IsNull() {
+		return
+	}
+
+	for _, v := range []struct {
+		attr  string
+		value attr.Value
+	}{
+		{"admin_user_name", config.AdminUserName},
+		{"admin_user_password", config.AdminUserPassword},
+		{"auth_type", config.AuthType},
+	} {
+		if v.value.IsNull() {
+			response.Diagnostics.AddAttributeError(
+				path.Root(v.attr),
+				"Missing required argument",
+				fmt.Sprintf("The argument %q is required when \"snapshot_arn\" is not set.", v.attr),
+			)
+		}
+	}
+}
+
 func (r *clusterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	conn := r.Meta().DocDBElasticClient(ctx)
 	var plan clusterResourceModel
@@ -184,30 +230,56 @@ func (r *clusterResource) Create(ctx context.Context, request resource.CreateReq
 	}
 
 	optionPrefix := fwflex.WithFieldNamePrefix("Cluster")
-	input := docdbelastic.CreateClusterInput{}
-	response.Diagnostics.Append(fwflex.Expand(ctx, plan, &input, optionPrefix)...)
+	var clusterARN *string
 
-	if response.Diagnostics.HasError() {
-		return
-	}
-	input.ClientToken = aws.String(id.UniqueId())
-	input.Tags = getTagsIn(ctx)
+	if !plan.SnapshotARN.IsNull() {
+		input := docdbelastic.RestoreClusterFromSnapshotInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, plan, &input, optionPrefix)...)
 
-	createOut, err := conn.CreateCluster(ctx, &input)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.Tags = getTagsIn(ctx)
 
-	if err != nil {
-		response.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionCreating, ResNameCluster, plan.Name.ValueString(), err),
-			err.Error(),
-		)
-		return
+		restoreOut, err := conn.RestoreClusterFromSnapshot(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionCreating, ResNameCluster, plan.Name.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		clusterARN = restoreOut.Cluster.ClusterArn
+	} else {
+		input := docdbelastic.CreateClusterInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, plan, &input, optionPrefix)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.ClientToken = aws.String(id.UniqueId())
+		input.Tags = getTagsIn(ctx)
+
+		createOut, err := conn.CreateCluster(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionCreating, ResNameCluster, plan.Name.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		clusterARN = createOut.Cluster.ClusterArn
 	}
 
 	state := plan
-	state.ID = fwflex.StringToFramework(ctx, createOut.Cluster.ClusterArn)
+	state.ID = fwflex.StringToFramework(ctx, clusterARN)
 
 	// set partial state
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), createOut.Cluster.ClusterArn)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), clusterARN)...)
 
 	if response.Diagnostics.HasError() {
 		return
@@ -224,6 +296,29 @@ func (r *clusterResource) Create(ctx context.Context, request resource.CreateReq
 		return
 	}
 
+	// Settings that can't be passed when restoring from a snapshot are applied once the cluster is available.
+	if input := expandClusterRestoreUpdateInput(plan, out); input != nil {
+		_, err := conn.UpdateCluster(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionUpdating, ResNameCluster, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		out, err = waitClusterUpdated(ctx, conn, state.ID.ValueString(), createTimeout)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionWaitingForUpdate, ResNameCluster, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	response.Diagnostics.Append(fwflex.Flatten(ctx, out, &state, optionPrefix)...)
 
 	if response.Diagnostics.HasError() {
@@ -391,6 +486,7 @@ type clusterResourceModel struct {
 	PreferredMaintenanceWindow fwtypes.OnceAWeekWindow           `tfsdk:"preferred_maintenance_window"`
 	ShardCapacity              types.Int64                       `tfsdk:"shard_capacity"`
 	ShardCount                 types.Int64                       `tfsdk:"shard_count"`
+	SnapshotARN                fwtypes.ARN                       `tfsdk:"snapshot_arn"`
 	SubnetIds                  fwtypes.SetValueOf[types.String]  `tfsdk:"subnet_ids"`
 	Tags                       tftags.Map                        `tfsdk:"tags"`
 	TagsAll                    tftags.Map                        `tfsdk:"tags_all"`
@@ -398,6 +494,49 @@ type clusterResourceModel struct {
 	VpcSecurityGroupIds        fwtypes.SetValueOf[types.String]  `tfsdk:"vpc_security_group_ids"`
 }
 
+func expandClusterRestoreUpdateInput(plan clusterResourceModel, cluster *awstypes.Cluster) *docdbelastic.UpdateClusterInput {
+	if plan.SnapshotARN.IsNull() {
+		return nil
+	}
+
+	input := &docdbelastic.UpdateClusterInput{
+		ClientToken: aws.String(id.UniqueId()),
+		ClusterArn:  cluster.ClusterArn,
+	}
+	update := false
+
+	if v := plan.AdminUserPassword; !v.IsNull() {
+		input.AdminUserPassword = v.ValueStringPointer()
+		update = true
+	}
+
+	if v := plan.BackupRetentionPeriod; !v.IsUnknown() && !v.IsNull() && v.ValueInt32() != aws.ToInt32(cluster.BackupRetentionPeriod) {
+		input.BackupRetentionPeriod = v.ValueInt32Pointer()
+		update = true
+	}
+
+	if v := plan.PreferredBackupWindow; !v.IsUnknown() && !v.IsNull() && v.ValueString() != aws.ToString(cluster.PreferredBackupWindow) {
+		input.PreferredBackupWindow = v.ValueStringPointer()
+		update = true
+	}
+
+	if v := plan.PreferredMaintenanceWindow; !v.IsUnknown() && !v.IsNull() && !strings.EqualFold(v.ValueString(), aws.ToString(cluster.PreferredMaintenanceWindow)) {
+		input.PreferredMaintenanceWindow = v.ValueStringPointer()
+		update = true
+	}
+
+	if v := plan.ShardCount; !v.IsUnknown() && !v.IsNull() && v.ValueInt64() != int64(aws.ToInt32(cluster.ShardCount)) {
+		input.ShardCount = aws.Int32(int32(v.ValueInt64()))
+		update = true
+	}
+
+	if !update {
+		return nil
+	}
+
+	return input
+}
+
 func waitClusterCreated(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
 	stateConf := &sdkretry.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.StatusCreating),
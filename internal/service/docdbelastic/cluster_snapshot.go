@@ -0,0 +1,454 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docdbelastic
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdbelastic"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/docdbelastic/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_docdbelastic_cluster_snapshot", name="Cluster Snapshot")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/docdbelastic/types;awstypes;awstypes.ClusterSnapshot")
+func newClusterSnapshotResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &clusterSnapshotResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type clusterSnapshotResource struct {
+	framework.ResourceWithModel[clusterSnapshotResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+const (
+	ResNameClusterSnapshot = "Cluster Snapshot"
+)
+
+func (r *clusterSnapshotResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin_user_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cluster_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_creation_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"copy_tags": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("source_snapshot_arn")),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("source_snapshot_arn")),
+				},
+			},
+			"snapshot_creation_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snapshot_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"snapshot_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SnapshotType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_snapshot_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Status](),
+				Computed:   true,
+			},
+			names.AttrSubnetIDs: schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Computed:   true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVPCSecurityGroupIDs: schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Computed:   true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *clusterSnapshotResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("cluster_arn"),
+			path.MatchRoot("source_snapshot_arn"),
+		),
+	}
+}
+
+func (r *clusterSnapshotResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().DocDBElasticClient(ctx)
+	var plan clusterSnapshotResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.SnapshotName.ValueString()
+	var snapshot *awstypes.ClusterSnapshot
+
+	// A snapshot is either taken of a running cluster or copied from an existing snapshot.
+	// Copying from a snapshot in another Region is done by setting this resource's Region.
+	if !plan.SourceSnapshotARN.IsNull() {
+		input := docdbelastic.CopyClusterSnapshotInput{
+			CopyTags:           fwflex.BoolFromFramework(ctx, plan.CopyTags),
+			KmsKeyId:           fwflex.StringFromFramework(ctx, plan.KmsKeyID),
+			SnapshotArn:        fwflex.StringFromFramework(ctx, plan.SourceSnapshotARN),
+			Tags:               getTagsIn(ctx),
+			TargetSnapshotName: aws.String(name),
+		}
+
+		output, err := conn.CopyClusterSnapshot(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionCreating, ResNameClusterSnapshot, name, err),
+				err.Error(),
+			)
+			return
+		}
+
+		snapshot = output.Snapshot
+	} else {
+		input := docdbelastic.CreateClusterSnapshotInput{
+			ClusterArn:   fwflex.StringFromFramework(ctx, plan.ClusterARN),
+			SnapshotName: aws.String(name),
+			Tags:         getTagsIn(ctx),
+		}
+
+		output, err := conn.CreateClusterSnapshot(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionCreating, ResNameClusterSnapshot, name, err),
+				err.Error(),
+			)
+			return
+		}
+
+		snapshot = output.Snapshot
+	}
+
+	plan.ID = fwflex.StringToFramework(ctx, snapshot.SnapshotArn)
+
+	// set partial state
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), snapshot.SnapshotArn)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	out, err := waitClusterSnapshotCreated(ctx, conn, plan.ID.ValueString(), createTimeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionWaitingForCreation, ResNameClusterSnapshot, name, err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(plan.flatten(ctx, out)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *clusterSnapshotResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().DocDBElasticClient(ctx)
+	var state clusterSnapshotResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findClusterSnapshotByID(ctx, conn, state.ID.ValueString())
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionReading, ResNameClusterSnapshot, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(state.flatten(ctx, out)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *clusterSnapshotResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan clusterSnapshotResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags only.
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *clusterSnapshotResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().DocDBElasticClient(ctx)
+	var state clusterSnapshotResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting DocDB Elastic Cluster Snapshot", map[string]any{
+		names.AttrID: state.ID.ValueString(),
+	})
+
+	input := &docdbelastic.DeleteClusterSnapshotInput{
+		SnapshotArn: fwflex.StringFromFramework(ctx, state.ID),
+	}
+
+	_, err := conn.DeleteClusterSnapshot(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionDeleting, ResNameClusterSnapshot, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitClusterSnapshotDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionWaitingForDeletion, ResNameClusterSnapshot, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+type clusterSnapshotResourceModel struct {
+	framework.WithRegionModel
+	AdminUserName        types.String                              `tfsdk:"admin_user_name"`
+	ARN                  types.String                              `tfsdk:"arn"`
+	ClusterARN           fwtypes.ARN                               `tfsdk:"cluster_arn"`
+	ClusterCreationTime  types.String                              `tfsdk:"cluster_creation_time"`
+	CopyTags             types.Bool                                `tfsdk:"copy_tags"`
+	ID                   types.String                              `tfsdk:"id"`
+	KmsKeyID             types.String                              `tfsdk:"kms_key_id"`
+	SnapshotCreationTime types.String                              `tfsdk:"snapshot_creation_time"`
+	SnapshotName         types.String                              `tfsdk:"snapshot_name"`
+	SnapshotType         fwtypes.StringEnum[awstypes.SnapshotType] `tfsdk:"snapshot_type"`
+	SourceSnapshotARN    fwtypes.ARN                               `tfsdk:"source_snapshot_arn"`
+	Status               fwtypes.StringEnum[awstypes.Status]       `tfsdk:"status"`
+	SubnetIDs            fwtypes.SetValueOf[types.String]          `tfsdk:"subnet_ids"`
+	Tags                 tftags.Map                                `tfsdk:"tags"`
+	TagsAll              tftags.Map                                `tfsdk:"tags_all"`
+	Timeouts             timeouts.Value                            `tfsdk:"timeouts"`
+	VpcSecurityGroupIDs  fwtypes.SetValueOf[types.String]          `tfsdk:"vpc_security_group_ids"`
+}
+
+func (m *clusterSnapshotResourceModel) flatten(ctx context.Context, snapshot *awstypes.ClusterSnapshot) diag.Diagnostics {
+	diags := fwflex.Flatten(ctx, snapshot, m)
+	m.ARN = fwflex.StringToFramework(ctx, snapshot.SnapshotArn)
+	m.ID = fwflex.StringToFramework(ctx, snapshot.SnapshotArn)
+
+	return diags
+}
+
+func waitClusterSnapshotCreated(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.ClusterSnapshot, error) {
+	stateConf := &sdkretry.StateChangeConf{
+		Pending:        enum.Slice(awstypes.StatusCreating, awstypes.StatusCopying),
+		Target:         enum.Slice(awstypes.StatusActive),
+		Refresh:        statusClusterSnapshot(ctx, conn, id),
+		Timeout:        timeout,
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ClusterSnapshot); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitClusterSnapshotDeleted(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.ClusterSnapshot, error) {
+	stateConf := &sdkretry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusActive, awstypes.StatusDeleting),
+		Target:  []string{},
+		Refresh: statusClusterSnapshot(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ClusterSnapshot); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusClusterSnapshot(ctx context.Context, conn *docdbelastic.Client, id string) sdkretry.StateRefreshFunc {
+	return func() (any, string, error) {
+		out, err := findClusterSnapshotByID(ctx, conn, id)
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func findClusterSnapshotByID(ctx context.Context, conn *docdbelastic.Client, id string) (*awstypes.ClusterSnapshot, error) {
+	in := &docdbelastic.GetClusterSnapshotInput{
+		SnapshotArn: aws.String(id),
+	}
+	out, err := conn.GetClusterSnapshot(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &sdkretry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Snapshot == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Snapshot, nil
+}
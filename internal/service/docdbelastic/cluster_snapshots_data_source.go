@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docdbelastic
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/docdbelastic"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/docdbelastic/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_docdbelastic_cluster_snapshots", name="Cluster Snapshots")
+func newClusterSnapshotsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &clusterSnapshotsDataSource{}, nil
+}
+
+const (
+	DSNameClusterSnapshots = "Cluster Snapshots Data Source"
+)
+
+type clusterSnapshotsDataSource struct {
+	framework.DataSourceWithModel[clusterSnapshotsDataSourceModel]
+}
+
+func (d *clusterSnapshotsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cluster_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"snapshot_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("automated", "manual"),
+				},
+			},
+			"snapshots": framework.DataSourceComputedListOfObjectAttribute[clusterSnapshotInListModel](ctx),
+		},
+	}
+}
+
+func (d *clusterSnapshotsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	conn := d.Meta().DocDBElasticClient(ctx)
+	var data clusterSnapshotsDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := docdbelastic.ListClusterSnapshotsInput{
+		ClusterArn:   fwflex.StringFromFramework(ctx, data.ClusterARN),
+		SnapshotType: fwflex.StringFromFramework(ctx, data.SnapshotType),
+	}
+
+	snapshots, err := findClusterSnapshots(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionReading, DSNameClusterSnapshots, data.ClusterARN.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, snapshots, &data.Snapshots)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type clusterSnapshotsDataSourceModel struct {
+	framework.WithRegionModel
+	ClusterARN   fwtypes.ARN                                                 `tfsdk:"cluster_arn"`
+	SnapshotType types.String                                                `tfsdk:"snapshot_type"`
+	Snapshots    fwtypes.ListNestedObjectValueOf[clusterSnapshotInListModel] `tfsdk:"snapshots"`
+}
+
+type clusterSnapshotInListModel struct {
+	ClusterARN           types.String                        `tfsdk:"cluster_arn"`
+	SnapshotARN          types.String                        `tfsdk:"snapshot_arn"`
+	SnapshotCreationTime types.String                        `tfsdk:"snapshot_creation_time"`
+	SnapshotName         types.String                        `tfsdk:"snapshot_name"`
+	Status               fwtypes.StringEnum[awstypes.Status] `tfsdk:"status"`
+}
+
+func findClusterSnapshots(ctx context.Context, conn *docdbelastic.Client, input *docdbelastic.ListClusterSnapshotsInput) ([]awstypes.ClusterSnapshotInList, error) {
+	var output []awstypes.ClusterSnapshotInList
+
+	pages := docdbelastic.NewListClusterSnapshotsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Snapshots...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docdbelastic_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDocDBElasticClusterSnapshotsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_docdbelastic_cluster_snapshots.test"
+	resourceName := "aws_docdbelastic_cluster_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBElasticServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterSnapshotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSnapshotsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "snapshots.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshots.0.cluster_arn", resourceName, "cluster_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshots.0.snapshot_arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshots.0.snapshot_creation_time", resourceName, "snapshot_creation_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshots.0.snapshot_name", resourceName, "snapshot_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "snapshots.0.status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterSnapshotsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterSnapshotConfig_basic(rName), `
+data "aws_docdbelastic_cluster_snapshots" "test" {
+  cluster_arn   = aws_docdbelastic_cluster_snapshot.test.cluster_arn
+  snapshot_type = "manual"
+}
+`)
+}
@@ -5,7 +5,9 @@ package docdbelastic
 
 // Exports for use in tests only.
 var (
-	ResourceCluster = newClusterResource
+	ResourceCluster         = newClusterResource
+	ResourceClusterSnapshot = newClusterSnapshotResource
 
-	FindClusterByID = findClusterByID
+	FindClusterByID         = findClusterByID
+	FindClusterSnapshotByID = findClusterSnapshotByID
 )
@@ -21,6 +21,14 @@ func RegisterSweepers() {
 	resource.AddTestSweepers("aws_docdbelastic_cluster", &resource.Sweeper{
 		Name: "aws_docdbelastic_cluster",
 		F:    sweepClusters,
+		Dependencies: []string{
+			"aws_docdbelastic_cluster_snapshot",
+		},
+	})
+
+	resource.AddTestSweepers("aws_docdbelastic_cluster_snapshot", &resource.Sweeper{
+		Name: "aws_docdbelastic_cluster_snapshot",
+		F:    sweepClusterSnapshots,
 	})
 }
 
@@ -66,3 +74,48 @@ func sweepClusters(region string) error {
 
 	return nil
 }
+
+func sweepClusterSnapshots(region string) error {
+	ctx := sweep.Context(region)
+	if region == endpoints.UsWest1RegionID {
+		log.Printf("[WARN] Skipping DocDB Elastic Cluster Snapshot sweep for region: %s", region)
+		return nil
+	}
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.DocDBElasticClient(ctx)
+	input := &docdbelastic.ListClusterSnapshotsInput{
+		SnapshotType: aws.String("manual"),
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := docdbelastic.NewListClusterSnapshotsPaginator(conn, input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping DocDB Elastic Cluster Snapshots sweep for %s: %s", region, err)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error retrieving DocDB Elastic Cluster Snapshots: %w", err)
+		}
+
+		for _, snapshot := range page.Snapshots {
+			arn := aws.ToString(snapshot.SnapshotArn)
+
+			log.Printf("[INFO] Deleting DocDB Elastic Cluster Snapshot: %s", aws.ToString(snapshot.SnapshotName))
+			sweepResources = append(sweepResources, framework.NewSweepResource(newClusterSnapshotResource, client,
+				framework.NewAttribute(names.AttrID, arn),
+			))
+		}
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping DocDB Elastic Cluster Snapshots for %s: %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package emrserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_emrserverless_job_run_dashboard, name="Job Run Dashboard")
+func newJobRunDashboardEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &jobRunDashboardEphemeralResource{}, nil
+}
+
+type jobRunDashboardEphemeralResource struct {
+	framework.EphemeralResourceWithModel[jobRunDashboardEphemeralResourceModel]
+}
+
+func (e *jobRunDashboardEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_system_profile_logs": schema.BoolAttribute{
+				Optional: true,
+			},
+			"application_id": schema.StringAttribute{
+				Required: true,
+			},
+			"attempt": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"job_run_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrURL: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *jobRunDashboardEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().EMRServerlessClient(ctx)
+	data := jobRunDashboardEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var input emrserverless.GetDashboardForJobRunInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.GetDashboardForJobRun(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, data.JobRunID.ValueString())
+		return
+	}
+
+	data.URL = fwflex.StringToFramework(ctx, output.Url)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type jobRunDashboardEphemeralResourceModel struct {
+	framework.WithRegionModel
+	AccessSystemProfileLogs types.Bool   `tfsdk:"access_system_profile_logs"`
+	ApplicationID           types.String `tfsdk:"application_id"`
+	Attempt                 types.Int32  `tfsdk:"attempt"`
+	JobRunID                types.String `tfsdk:"job_run_id"`
+	URL                     types.String `tfsdk:"url"`
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package emrserverless_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEMRServerlessJobRunDashboardEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	applicationID := acctest.SkipIfEnvVarNotSet(t, "EMR_SERVERLESS_APPLICATION_ID")
+	jobRunID := acctest.SkipIfEnvVarNotSet(t, "EMR_SERVERLESS_JOB_RUN_ID")
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobRunDashboardEphemeralConfig_basic(applicationID, jobRunID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("application_id"), knownvalue.StringExact(applicationID)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("job_run_id"), knownvalue.StringExact(jobRunID)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrURL), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccJobRunDashboardEphemeralConfig_basic(applicationID, jobRunID string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_emrserverless_job_run_dashboard.test"),
+		fmt.Sprintf(`
+ephemeral "aws_emrserverless_job_run_dashboard" "test" {
+  application_id = %[1]q
+  job_run_id     = %[2]q
+}
+`, applicationID, jobRunID))
+}
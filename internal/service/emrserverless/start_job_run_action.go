@@ -0,0 +1,322 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package emrserverless
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/emrserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	jobRunPollInterval     = 30 * time.Second
+	jobRunProgressInterval = 2 * time.Minute
+)
+
+// @Action(aws_emrserverless_start_job_run, name="Start Job Run")
+func newStartJobRunAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startJobRunAction{}, nil
+}
+
+var (
+	_ action.Action = (*startJobRunAction)(nil)
+)
+
+type startJobRunAction struct {
+	framework.ActionWithModel[startJobRunActionModel]
+}
+
+type startJobRunActionModel struct {
+	framework.WithRegionModel
+	ApplicationID           types.String                                      `tfsdk:"application_id"`
+	ExecutionRoleARN        fwtypes.ARN                                       `tfsdk:"execution_role_arn"`
+	ExecutionTimeoutMinutes types.Int64                                       `tfsdk:"execution_timeout_minutes"`
+	Hive                    fwtypes.ListNestedObjectValueOf[hiveModel]        `tfsdk:"hive"`
+	Name                    types.String                                      `tfsdk:"name"`
+	SparkSubmit             fwtypes.ListNestedObjectValueOf[sparkSubmitModel] `tfsdk:"spark_submit"`
+	Timeout                 types.Int64                                       `tfsdk:"timeout"`
+}
+
+type hiveModel struct {
+	InitQueryFile types.String `tfsdk:"init_query_file"`
+	Parameters    types.String `tfsdk:"parameters"`
+	Query         types.String `tfsdk:"query"`
+}
+
+type sparkSubmitModel struct {
+	EntryPoint            types.String         `tfsdk:"entry_point"`
+	EntryPointArguments   fwtypes.ListOfString `tfsdk:"entry_point_arguments"`
+	SparkSubmitParameters types.String         `tfsdk:"spark_submit_parameters"`
+}
+
+func (a *startJobRunAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	jobDriverValidators := []validator.List{
+		listvalidator.SizeAtMost(1),
+		listvalidator.ExactlyOneOf(
+			path.MatchRoot("hive"),
+			path.MatchRoot("spark_submit"),
+		),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Starts an Amazon EMR Serverless job run and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "The ID of the EMR Serverless application to run the job on.",
+				Required:    true,
+			},
+			"execution_role_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the IAM role the job run assumes.",
+				Required:    true,
+			},
+			"execution_timeout_minutes": schema.Int64Attribute{
+				Description: "The maximum duration of the job run in minutes, after which EMR Serverless cancels it.",
+				Optional:    true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Description: "The name of the job run.",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Maximum time in seconds to wait for the job run to complete. Defaults to 3600 seconds (60 minutes).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(60, 86400),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"hive": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[hiveModel](ctx),
+				Description: "The Hive job driver.",
+				Validators:  jobDriverValidators,
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"init_query_file": schema.StringAttribute{
+							Description: "The S3 location of the query file to run before the main query.",
+							Optional:    true,
+						},
+						names.AttrParameters: schema.StringAttribute{
+							Description: "The parameters for the Hive job run.",
+							Optional:    true,
+						},
+						"query": schema.StringAttribute{
+							Description: "The S3 location of the query file to run.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"spark_submit": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[sparkSubmitModel](ctx),
+				Description: "The Spark submit job driver.",
+				Validators:  jobDriverValidators,
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"entry_point": schema.StringAttribute{
+							Description: "The S3 location of the entry point script or JAR.",
+							Required:    true,
+						},
+						"entry_point_arguments": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							Description: "The arguments passed to the entry point.",
+							Optional:    true,
+						},
+						"spark_submit_parameters": schema.StringAttribute{
+							Description: "The Spark submit parameters, such as --conf settings.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *startJobRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startJobRunActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().EMRServerlessClient(ctx)
+
+	applicationID := config.ApplicationID.ValueString()
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting job run action", map[string]any{
+		"application_id":  applicationID,
+		"timeout_seconds": int64(timeout.Seconds()),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting job run on EMR Serverless application %s...", applicationID),
+	})
+
+	input := emrserverless.StartJobRunInput{
+		ApplicationId:           aws.String(applicationID),
+		ClientToken:             aws.String(sdkid.UniqueId()),
+		ExecutionRoleArn:        config.ExecutionRoleARN.ValueStringPointer(),
+		ExecutionTimeoutMinutes: config.ExecutionTimeoutMinutes.ValueInt64Pointer(),
+		Name:                    config.Name.ValueStringPointer(),
+	}
+
+	switch {
+	case !config.Hive.IsNull():
+		hiveData, d := config.Hive.ToPtr(ctx)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var jobDriver awstypes.JobDriverMemberHive
+		resp.Diagnostics.Append(fwflex.Expand(ctx, hiveData, &jobDriver.Value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		input.JobDriver = &jobDriver
+
+	case !config.SparkSubmit.IsNull():
+		sparkSubmitData, d := config.SparkSubmit.ToPtr(ctx)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var jobDriver awstypes.JobDriverMemberSparkSubmit
+		resp.Diagnostics.Append(fwflex.Expand(ctx, sparkSubmitData, &jobDriver.Value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		input.JobDriver = &jobDriver
+	}
+
+	output, err := conn.StartJobRun(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Job Run",
+			fmt.Sprintf("Could not start job run on EMR Serverless application %s: %s", applicationID, err),
+		)
+		return
+	}
+
+	jobRunID := aws.ToString(output.JobRunId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Job run %s started, waiting for completion...", jobRunID),
+	})
+
+	// Report every state transition, and otherwise only periodically while the state is unchanged.
+	var lastState actionwait.Status
+	var lastReport time.Time
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.JobRun], error) {
+		input := emrserverless.GetJobRunInput{
+			ApplicationId: aws.String(applicationID),
+			JobRunId:      aws.String(jobRunID),
+		}
+		output, err := conn.GetJobRun(ctx, &input)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.JobRun]{}, fmt.Errorf("get job run: %w", err)
+		}
+		if output.JobRun == nil {
+			return actionwait.FetchResult[*awstypes.JobRun]{}, fmt.Errorf("job run %s not found", jobRunID)
+		}
+		return actionwait.FetchResult[*awstypes.JobRun]{Status: actionwait.Status(output.JobRun.State), Value: output.JobRun}, nil
+	}, actionwait.Options[*awstypes.JobRun]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(jobRunPollInterval),
+		ProgressInterval: jobRunPollInterval,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobRunStateSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobRunStateSubmitted),
+			actionwait.Status(awstypes.JobRunStatePending),
+			actionwait.Status(awstypes.JobRunStateScheduled),
+			actionwait.Status(awstypes.JobRunStateQueued),
+			actionwait.Status(awstypes.JobRunStateRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobRunStateFailed),
+			actionwait.Status(awstypes.JobRunStateCancelling),
+			actionwait.Status(awstypes.JobRunStateCancelled),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if fr.Status == lastState && time.Since(lastReport) < jobRunProgressInterval {
+				return
+			}
+			lastState, lastReport = fr.Status, time.Now()
+
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Job run %s is currently %s", jobRunID, fr.Status)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Job Run",
+				fmt.Sprintf("Job run %s did not complete within %v", jobRunID, timeout),
+			)
+		} else if errors.As(err, &failureErr) {
+			message := ""
+			if fr.Value != nil {
+				message = aws.ToString(fr.Value.StateDetails)
+			}
+			resp.Diagnostics.AddError(
+				"Job Run Failed",
+				fmt.Sprintf("Job run %s failed with state %s: %s", jobRunID, failureErr.Status, message),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Job Run State",
+				fmt.Sprintf("Job run %s entered unexpected state: %s", jobRunID, unexpectedErr.Status),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Job Run",
+				fmt.Sprintf("Error while waiting for job run %s: %s", jobRunID, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Job run %s finished with state %s", jobRunID, fr.Status)})
+	tflog.Info(ctx, "Job run completed", map[string]any{
+		"application_id": applicationID,
+		"job_run_id":     jobRunID,
+		"job_run_state":  fr.Status,
+	})
+}
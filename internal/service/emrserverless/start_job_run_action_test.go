@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package emrserverless_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless"
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEMRServerlessStartJobRunAction_sparkSubmit(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_emrserverless_application.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartJobRunActionConfig_sparkSubmit(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunState(ctx, t, resourceName, rName, types.JobRunStateSuccess),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJobRunState(ctx context.Context, t *testing.T, n, jobRunName string, expected types.JobRunState) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).EMRServerlessClient(ctx)
+
+		input := emrserverless.ListJobRunsInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		}
+		pages := emrserverless.NewListJobRunsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return fmt.Errorf("listing job runs for EMR Serverless Application %s: %w", rs.Primary.ID, err)
+			}
+
+			for _, v := range page.JobRuns {
+				if aws.ToString(v.Name) != jobRunName {
+					continue
+				}
+
+				if v.State != expected {
+					return fmt.Errorf("expected EMR Serverless Job Run %s state to be %s, got %s", aws.ToString(v.Id), expected, v.State)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("EMR Serverless Job Run %s not found for application %s", jobRunName, rs.Primary.ID)
+	}
+}
+
+func testAccStartJobRunActionConfig_sparkSubmit(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "emr-serverless.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_emrserverless_application" "test" {
+  name          = %[1]q
+  release_label = "emr-7.1.0"
+  type          = "spark"
+}
+
+action "aws_emrserverless_start_job_run" "test" {
+  config {
+    application_id     = aws_emrserverless_application.test.id
+    execution_role_arn = aws_iam_role.test.arn
+    name               = %[1]q
+    timeout            = 1800
+
+    spark_submit {
+      entry_point             = "local:///usr/lib/spark/examples/src/main/python/pi.py"
+      entry_point_arguments   = ["10"]
+      spark_submit_parameters = "--conf spark.executor.cores=1 --conf spark.executor.memory=2g --conf spark.driver.cores=1 --conf spark.driver.memory=2g --conf spark.executor.instances=1"
+    }
+  }
+}
+
+resource "terraform_data" "test" {
+  input = "completed"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_emrserverless_start_job_run.test]
+    }
+  }
+
+  depends_on = [aws_emrserverless_application.test, aws_iam_role.test]
+}
+`, rName)
+}
@@ -0,0 +1,903 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package evs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_evs_environment", name="Environment")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newEnvironmentResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &environmentResource{}
+
+	r.SetDefaultCreateTimeout(6 * time.Hour)
+	r.SetDefaultUpdateTimeout(2 * time.Hour)
+	r.SetDefaultDeleteTimeout(6 * time.Hour)
+
+	return r, nil
+}
+
+type environmentResource struct {
+	framework.ResourceWithModel[environmentResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *environmentResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	requiredStringAttribute := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Required: true,
+		}
+	}
+	vlanBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[initialVlanInfoModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"cidr": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							fwvalidators.IPv4CIDRNetworkAddress(),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"credentials": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[secretModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[secretModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"environment_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EnvironmentState](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_access_subnet_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(verify.SubnetIDRegexp, "value must be a subnet ID"),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"terms_accepted": schema.BoolAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"vcf_version": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.VcfVersion](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrVPCID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(verify.VPCIDRegexp, "value must be a VPC ID"),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"connectivity_info": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[connectivityInfoModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"private_route_server_peerings": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(1, 2),
+							},
+						},
+					},
+				},
+			},
+			"host": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[hostInfoModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeBetween(4, 16),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"dedicated_host_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"host_name": schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrInstanceType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.InstanceType](),
+							Required:   true,
+						},
+						"key_name": schema.StringAttribute{
+							Required: true,
+						},
+						"placement_group_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"initial_vlans": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[initialVlansModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					// The initial VLANs are not returned by the API, so an imported environment has none in state.
+					listplanmodifier.RequiresReplaceIf(func(ctx context.Context, request planmodifier.ListRequest, response *listplanmodifier.RequiresReplaceIfFuncResponse) {
+						response.RequiresReplace = !request.StateValue.IsNull()
+					}, "Replacement is required when the initial VLANs change.", "Replacement is required when the initial VLANs change."),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"hcx_network_acl_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"is_hcx_public": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"edge_vtep":        vlanBlock(),
+						"expansion_vlan_1": vlanBlock(),
+						"expansion_vlan_2": vlanBlock(),
+						"hcx":              vlanBlock(),
+						"nsx_uplink":       vlanBlock(),
+						"vm_management":    vlanBlock(),
+						"vmk_management":   vlanBlock(),
+						"vmotion":          vlanBlock(),
+						"vsan":             vlanBlock(),
+						"vtep":             vlanBlock(),
+					},
+				},
+			},
+			"license_info": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[licenseInfoModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"solution_key": requiredStringAttribute(),
+						"vsan_key":     requiredStringAttribute(),
+					},
+				},
+			},
+			"service_access_security_groups": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[serviceAccessSecurityGroupsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSecurityGroups: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(
+									stringvalidator.RegexMatches(verify.SecurityGroupIDRegexp, "value must be a security group ID"),
+								),
+							},
+						},
+					},
+				},
+			},
+			"vcf_hostnames": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vcfHostnamesModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cloud_builder": requiredStringAttribute(),
+						"nsx":           requiredStringAttribute(),
+						"nsx_edge_1":    requiredStringAttribute(),
+						"nsx_edge_2":    requiredStringAttribute(),
+						"nsx_manager_1": requiredStringAttribute(),
+						"nsx_manager_2": requiredStringAttribute(),
+						"nsx_manager_3": requiredStringAttribute(),
+						"sddc_manager":  requiredStringAttribute(),
+						"vcenter":       requiredStringAttribute(),
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *environmentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data environmentResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	name := data.EnvironmentName.ValueString()
+	var input evs.CreateEnvironmentInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateEnvironment(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	id := aws.ToString(output.Environment.EnvironmentId)
+	environment, err := waitEnvironmentCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint.
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, data.flatten(ctx, environment))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *environmentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data environmentResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	id := data.ID.ValueString()
+	output, err := findEnvironmentByID(ctx, conn, id)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	// GetEnvironment does not return the initial VLANs, so they are left as-is in state.
+	smerr.AddEnrich(ctx, &response.Diagnostics, data.flatten(ctx, output))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	hosts, err := findEnvironmentHostsByID(ctx, conn, id)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, hosts, &data.Hosts))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *environmentResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Hosts can only be changed on update.
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state environmentResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Hosts.IsUnknown() {
+		return
+	}
+
+	oldHosts, d := state.Hosts.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	newHosts, d := plan.Hosts.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for _, hostName := range changedHostNames(oldHosts, newHosts) {
+		response.Diagnostics.AddAttributeError(
+			path.Root("host"),
+			"Unsupported host change",
+			fmt.Sprintf("The attributes of host %q can't be changed in place. To replace the host, remove it and add a host with a different host_name.", hostName),
+		)
+	}
+}
+
+func (r *environmentResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old environmentResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	id := new.ID.ValueString()
+	if !new.Hosts.Equal(old.Hosts) {
+		oldHosts, d := old.Hosts.ToSlice(ctx)
+		smerr.AddEnrich(ctx, &response.Diagnostics, d)
+		newHosts, d := new.Hosts.ToSlice(ctx)
+		smerr.AddEnrich(ctx, &response.Diagnostics, d)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Hosts are identified by name and can't be modified in place.
+		if hostNames := changedHostNames(oldHosts, newHosts); len(hostNames) > 0 {
+			smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("attributes of hosts (%s) can't be changed in place", strings.Join(hostNames, ", ")), smerr.ID, id)
+			return
+		}
+
+		add, remove, _ := intflex.DiffSlices(oldHosts, newHosts, func(v1, v2 *hostInfoModel) bool {
+			return v1.HostName.Equal(v2.HostName)
+		})
+
+		timeout := r.UpdateTimeout(ctx, new.Timeouts)
+
+		// Add hosts before removing any so that the environment keeps its minimum host count.
+		for _, v := range add {
+			var host awstypes.HostInfoForCreate
+			smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, v, &host))
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			if err := createEnvironmentHost(ctx, conn, id, &host, timeout); err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+				return
+			}
+		}
+
+		for _, v := range remove {
+			if err := deleteEnvironmentHost(ctx, conn, id, v.HostName.ValueString(), timeout); err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+				return
+			}
+		}
+	}
+
+	output, err := findEnvironmentByID(ctx, conn, id)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, new.flatten(ctx, output))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *environmentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data environmentResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	id := data.ID.ValueString()
+	input := evs.DeleteEnvironmentInput{
+		ClientToken:   aws.String(sdkid.UniqueId()),
+		EnvironmentId: aws.String(id),
+	}
+	_, err := conn.DeleteEnvironment(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	if _, err := waitEnvironmentDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+// changedHostNames returns the names of hosts that are in both old and new but whose attributes differ.
+// Unknown new attribute values are not treated as changes.
+func changedHostNames(oldHosts, newHosts []*hostInfoModel) []string {
+	var hostNames []string
+
+	for _, new := range newHosts {
+		for _, old := range oldHosts {
+			if new.HostName.IsUnknown() || !new.HostName.Equal(old.HostName) {
+				continue
+			}
+
+			if !stringValueUnchanged(old.DedicatedHostID, new.DedicatedHostID) ||
+				!stringValueUnchanged(old.InstanceType.StringValue, new.InstanceType.StringValue) ||
+				!stringValueUnchanged(old.KeyName, new.KeyName) ||
+				!stringValueUnchanged(old.PlacementGroupID, new.PlacementGroupID) {
+				hostNames = append(hostNames, new.HostName.ValueString())
+			}
+		}
+	}
+
+	return hostNames
+}
+
+func stringValueUnchanged(old, new types.String) bool {
+	return new.IsUnknown() || old.Equal(new)
+}
+
+func createEnvironmentHost(ctx context.Context, conn *evs.Client, environmentID string, host *awstypes.HostInfoForCreate, timeout time.Duration) error {
+	hostName := aws.ToString(host.HostName)
+	input := evs.CreateEnvironmentHostInput{
+		ClientToken:   aws.String(sdkid.UniqueId()),
+		EnvironmentId: aws.String(environmentID),
+		Host:          host,
+	}
+	_, err := conn.CreateEnvironmentHost(ctx, &input)
+
+	if err != nil {
+		return smarterr.NewError(err)
+	}
+
+	if _, err := waitEnvironmentHostCreated(ctx, conn, environmentID, hostName, timeout); err != nil {
+		return smarterr.NewError(err)
+	}
+
+	return nil
+}
+
+func deleteEnvironmentHost(ctx context.Context, conn *evs.Client, environmentID, hostName string, timeout time.Duration) error {
+	input := evs.DeleteEnvironmentHostInput{
+		ClientToken:   aws.String(sdkid.UniqueId()),
+		EnvironmentId: aws.String(environmentID),
+		HostName:      aws.String(hostName),
+	}
+	_, err := conn.DeleteEnvironmentHost(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return smarterr.NewError(err)
+	}
+
+	if _, err := waitEnvironmentHostDeleted(ctx, conn, environmentID, hostName, timeout); err != nil {
+		return smarterr.NewError(err)
+	}
+
+	return nil
+}
+
+func findEnvironmentByID(ctx context.Context, conn *evs.Client, id string) (*awstypes.Environment, error) {
+	input := evs.GetEnvironmentInput{
+		EnvironmentId: aws.String(id),
+	}
+	output, err := conn.GetEnvironment(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Environment == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	if state := output.Environment.EnvironmentState; state == awstypes.EnvironmentStateDeleted {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: string(state),
+		})
+	}
+
+	return output.Environment, nil
+}
+
+func findEnvironmentHostsByID(ctx context.Context, conn *evs.Client, id string) ([]awstypes.Host, error) {
+	input := evs.ListEnvironmentHostsInput{
+		EnvironmentId: aws.String(id),
+	}
+
+	return findEnvironmentHosts(ctx, conn, &input, func(v *awstypes.Host) bool {
+		return v.HostState != awstypes.HostStateDeleted
+	})
+}
+
+func findEnvironmentHostByTwoPartKey(ctx context.Context, conn *evs.Client, environmentID, hostName string) (*awstypes.Host, error) {
+	input := evs.ListEnvironmentHostsInput{
+		EnvironmentId: aws.String(environmentID),
+	}
+	output, err := findEnvironmentHosts(ctx, conn, &input, func(v *awstypes.Host) bool {
+		return aws.ToString(v.HostName) == hostName && v.HostState != awstypes.HostStateDeleted
+	})
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	return smarterr.Assert(tfresource.AssertSingleValueResult(output))
+}
+
+func findEnvironmentHosts(ctx context.Context, conn *evs.Client, input *evs.ListEnvironmentHostsInput, filter tfslices.Predicate[*awstypes.Host]) ([]awstypes.Host, error) {
+	var output []awstypes.Host
+
+	pages := evs.NewListEnvironmentHostsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, smarterr.NewError(&retry.NotFoundError{
+				LastError: err,
+			})
+		}
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		for _, v := range page.EnvironmentHosts {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func statusEnvironment(conn *evs.Client, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findEnvironmentByID(ctx, conn, id)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", smarterr.NewError(err)
+		}
+
+		return output, string(output.EnvironmentState), nil
+	}
+}
+
+func statusEnvironmentHost(conn *evs.Client, environmentID, hostName string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findEnvironmentHostByTwoPartKey(ctx, conn, environmentID, hostName)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", smarterr.NewError(err)
+		}
+
+		return output, string(output.HostState), nil
+	}
+}
+
+func waitEnvironmentCreated(ctx context.Context, conn *evs.Client, id string, timeout time.Duration) (*awstypes.Environment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.EnvironmentStateCreating),
+		Target:       enum.Slice(awstypes.EnvironmentStateCreated),
+		Refresh:      statusEnvironment(conn, id),
+		Timeout:      timeout,
+		PollInterval: 1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Environment); ok {
+		retry.SetLastError(err, errors.New(aws.ToString(output.StateDetails)))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitEnvironmentDeleted(ctx context.Context, conn *evs.Client, id string, timeout time.Duration) (*awstypes.Environment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.EnvironmentStateCreated, awstypes.EnvironmentStateCreateFailed, awstypes.EnvironmentStateDeleting),
+		Target:       []string{},
+		Refresh:      statusEnvironment(conn, id),
+		Timeout:      timeout,
+		PollInterval: 1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Environment); ok {
+		retry.SetLastError(err, errors.New(aws.ToString(output.StateDetails)))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitEnvironmentHostCreated(ctx context.Context, conn *evs.Client, environmentID, hostName string, timeout time.Duration) (*awstypes.Host, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.HostStateCreating, awstypes.HostStateUpdating),
+		Target:  enum.Slice(awstypes.HostStateCreated),
+		Refresh: statusEnvironmentHost(conn, environmentID, hostName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Host); ok {
+		retry.SetLastError(err, errors.New(aws.ToString(output.StateDetails)))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitEnvironmentHostDeleted(ctx context.Context, conn *evs.Client, environmentID, hostName string, timeout time.Duration) (*awstypes.Host, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.HostStateCreated, awstypes.HostStateUpdating, awstypes.HostStateDeleting),
+		Target:  []string{},
+		Refresh: statusEnvironmentHost(conn, environmentID, hostName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Host); ok {
+		retry.SetLastError(err, errors.New(aws.ToString(output.StateDetails)))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+type environmentResourceModel struct {
+	framework.WithRegionModel
+	ARN                         types.String                                                      `tfsdk:"arn"`
+	ConnectivityInfo            fwtypes.ListNestedObjectValueOf[connectivityInfoModel]            `tfsdk:"connectivity_info"`
+	Credentials                 fwtypes.ListNestedObjectValueOf[secretModel]                      `tfsdk:"credentials"`
+	EnvironmentName             types.String                                                      `tfsdk:"environment_name"`
+	EnvironmentState            fwtypes.StringEnum[awstypes.EnvironmentState]                     `tfsdk:"environment_state"`
+	Hosts                       fwtypes.SetNestedObjectValueOf[hostInfoModel]                     `tfsdk:"host"`
+	ID                          types.String                                                      `tfsdk:"id"`
+	InitialVlans                fwtypes.ListNestedObjectValueOf[initialVlansModel]                `tfsdk:"initial_vlans"`
+	KMSKeyID                    types.String                                                      `tfsdk:"kms_key_id"`
+	LicenseInfo                 fwtypes.ListNestedObjectValueOf[licenseInfoModel]                 `tfsdk:"license_info"`
+	ServiceAccessSecurityGroups fwtypes.ListNestedObjectValueOf[serviceAccessSecurityGroupsModel] `tfsdk:"service_access_security_groups"`
+	ServiceAccessSubnetID       types.String                                                      `tfsdk:"service_access_subnet_id"`
+	SiteID                      types.String                                                      `tfsdk:"site_id"`
+	Tags                        tftags.Map                                                        `tfsdk:"tags"`
+	TagsAll                     tftags.Map                                                        `tfsdk:"tags_all"`
+	TermsAccepted               types.Bool                                                        `tfsdk:"terms_accepted"`
+	Timeouts                    timeouts.Value                                                    `tfsdk:"timeouts"`
+	VcfHostnames                fwtypes.ListNestedObjectValueOf[vcfHostnamesModel]                `tfsdk:"vcf_hostnames"`
+	VcfVersion                  fwtypes.StringEnum[awstypes.VcfVersion]                           `tfsdk:"vcf_version"`
+	VpcID                       types.String                                                      `tfsdk:"vpc_id"`
+}
+
+func (data *environmentResourceModel) flatten(ctx context.Context, environment *awstypes.Environment) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, environment, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, environment.EnvironmentArn)
+	data.ID = fwflex.StringToFramework(ctx, environment.EnvironmentId)
+
+	return diags
+}
+
+type connectivityInfoModel struct {
+	PrivateRouteServerPeerings fwtypes.SetOfString `tfsdk:"private_route_server_peerings"`
+}
+
+type secretModel struct {
+	SecretARN types.String `tfsdk:"secret_arn"`
+}
+
+type hostInfoModel struct {
+	DedicatedHostID  types.String                              `tfsdk:"dedicated_host_id"`
+	HostName         types.String                              `tfsdk:"host_name"`
+	InstanceType     fwtypes.StringEnum[awstypes.InstanceType] `tfsdk:"instance_type"`
+	KeyName          types.String                              `tfsdk:"key_name"`
+	PlacementGroupID types.String                              `tfsdk:"placement_group_id"`
+}
+
+type initialVlansModel struct {
+	EdgeVTep        fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"edge_vtep"`
+	ExpansionVlan1  fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"expansion_vlan_1"`
+	ExpansionVlan2  fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"expansion_vlan_2"`
+	Hcx             fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"hcx"`
+	HcxNetworkACLID types.String                                          `tfsdk:"hcx_network_acl_id"`
+	IsHcxPublic     types.Bool                                            `tfsdk:"is_hcx_public"`
+	NsxUplink       fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"nsx_uplink"`
+	VmManagement    fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"vm_management"`
+	VmkManagement   fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"vmk_management"`
+	VMotion         fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"vmotion"`
+	VSan            fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"vsan"`
+	VTep            fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"vtep"`
+}
+
+type initialVlanInfoModel struct {
+	CIDR types.String `tfsdk:"cidr"`
+}
+
+type licenseInfoModel struct {
+	SolutionKey types.String `tfsdk:"solution_key"`
+	VsanKey     types.String `tfsdk:"vsan_key"`
+}
+
+type serviceAccessSecurityGroupsModel struct {
+	SecurityGroups fwtypes.SetOfString `tfsdk:"security_groups"`
+}
+
+type vcfHostnamesModel struct {
+	CloudBuilder types.String `tfsdk:"cloud_builder"`
+	Nsx          types.String `tfsdk:"nsx"`
+	NsxEdge1     types.String `tfsdk:"nsx_edge_1"`
+	NsxEdge2     types.String `tfsdk:"nsx_edge_2"`
+	NsxManager1  types.String `tfsdk:"nsx_manager_1"`
+	NsxManager2  types.String `tfsdk:"nsx_manager_2"`
+	NsxManager3  types.String `tfsdk:"nsx_manager_3"`
+	SddcManager  types.String `tfsdk:"sddc_manager"`
+	VCenter      types.String `tfsdk:"vcenter"`
+}
@@ -0,0 +1,314 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package evs_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfevs "github.com/hashicorp/terraform-provider-aws/internal/service/evs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Amazon EVS environments need a pre-provisioned VPC with Route Server peers,
+// a registered site and VCF license keys, so these are supplied via environment variables.
+type environmentTestVars struct {
+	keyName               string
+	routeServerPeeringIDs []string
+	serviceAccessSubnetID string
+	siteID                string
+	solutionKey           string
+	vpcID                 string
+	vsanKey               string
+}
+
+func testAccEnvironmentTestVars(t *testing.T) environmentTestVars {
+	t.Helper()
+
+	return environmentTestVars{
+		keyName:               acctest.SkipIfEnvVarNotSet(t, "AWS_EVS_KEY_NAME"),
+		routeServerPeeringIDs: strings.Split(acctest.SkipIfEnvVarNotSet(t, "AWS_EVS_ROUTE_SERVER_PEERING_IDS"), ","),
+		serviceAccessSubnetID: acctest.SkipIfEnvVarNotSet(t, "AWS_EVS_SERVICE_ACCESS_SUBNET_ID"),
+		siteID:                acctest.SkipIfEnvVarNotSet(t, "AWS_EVS_SITE_ID"),
+		solutionKey:           acctest.SkipIfEnvVarNotSet(t, "AWS_EVS_SOLUTION_KEY"),
+		vpcID:                 acctest.SkipIfEnvVarNotSet(t, "AWS_EVS_VPC_ID"),
+		vsanKey:               acctest.SkipIfEnvVarNotSet(t, "AWS_EVS_VSAN_KEY"),
+	}
+}
+
+func TestAccEVSEnvironment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	vars := testAccEnvironmentTestVars(t)
+	var v awstypes.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evs_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_basic(rName, vars, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "environment_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "environment_state", "CREATED"),
+					resource.TestCheckResourceAttr(resourceName, "host.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "site_id", vars.siteID),
+					resource.TestCheckResourceAttr(resourceName, "vcf_version", "VCF-5.2.1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVPCID, vars.vpcID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"initial_vlans"},
+			},
+		},
+	})
+}
+
+func TestAccEVSEnvironment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	vars := testAccEnvironmentTestVars(t)
+	var v awstypes.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evs_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_basic(rName, vars, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfevs.ResourceEnvironment, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEVSEnvironment_hosts(t *testing.T) {
+	ctx := acctest.Context(t)
+	vars := testAccEnvironmentTestVars(t)
+	var v awstypes.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evs_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_basic(rName, vars, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "host.#", "4"),
+				),
+			},
+			{
+				Config: testAccEnvironmentConfig_basic(rName, vars, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "host.#", "5"),
+				),
+			},
+			{
+				Config: testAccEnvironmentConfig_basic(rName, vars, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "host.#", "4"),
+				),
+			},
+			{
+				// Hosts keep their name, so changing their attributes in place is rejected at plan time.
+				Config:      testAccEnvironmentConfig_hostKeyName(rName, vars, 4, vars.keyName+"-changed"),
+				ExpectError: regexache.MustCompile(`Unsupported host change`),
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EVSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_evs_environment" {
+				continue
+			}
+
+			_, err := tfevs.FindEnvironmentByID(ctx, conn, rs.Primary.ID)
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EVS Environment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentExists(ctx context.Context, n string, v *awstypes.Environment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EVSClient(ctx)
+
+		output, err := tfevs.FindEnvironmentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EVSClient(ctx)
+
+	input := evs.ListEnvironmentsInput{}
+	_, err := conn.ListEnvironments(ctx, &input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccEnvironmentConfig_basic(rName string, vars environmentTestVars, hostCount int) string {
+	return testAccEnvironmentConfig_hostKeyName(rName, vars, hostCount, vars.keyName)
+}
+
+func testAccEnvironmentConfig_hostKeyName(rName string, vars environmentTestVars, hostCount int, keyName string) string {
+	return fmt.Sprintf(`
+resource "aws_evs_environment" "test" {
+  environment_name         = %[1]q
+  service_access_subnet_id = %[2]q
+  site_id                  = %[3]q
+  terms_accepted           = true
+  vcf_version              = "VCF-5.2.1"
+  vpc_id                   = %[4]q
+
+  connectivity_info {
+    private_route_server_peerings = %[5]s
+  }
+
+  dynamic "host" {
+    for_each = range(%[9]d)
+
+    content {
+      host_name     = "esx${host.value}"
+      instance_type = "i4i.metal"
+      key_name      = %[6]q
+    }
+  }
+
+  initial_vlans {
+    vmk_management {
+      cidr = "10.0.8.0/24"
+    }
+
+    vm_management {
+      cidr = "10.0.9.0/24"
+    }
+
+    vmotion {
+      cidr = "10.0.10.0/24"
+    }
+
+    vsan {
+      cidr = "10.0.11.0/24"
+    }
+
+    vtep {
+      cidr = "10.0.12.0/24"
+    }
+
+    edge_vtep {
+      cidr = "10.0.13.0/24"
+    }
+
+    nsx_uplink {
+      cidr = "10.0.14.0/24"
+    }
+
+    hcx {
+      cidr = "10.0.15.0/24"
+    }
+
+    expansion_vlan_1 {
+      cidr = "10.0.16.0/24"
+    }
+
+    expansion_vlan_2 {
+      cidr = "10.0.17.0/24"
+    }
+  }
+
+  license_info {
+    solution_key = %[7]q
+    vsan_key     = %[8]q
+  }
+
+  vcf_hostnames {
+    cloud_builder = "cb"
+    nsx           = "nsx"
+    nsx_edge_1    = "edge1"
+    nsx_edge_2    = "edge2"
+    nsx_manager_1 = "nsxm1"
+    nsx_manager_2 = "nsxm2"
+    nsx_manager_3 = "nsxm3"
+    sddc_manager  = "sddcm"
+    vcenter       = "vc"
+  }
+}
+`, rName, vars.serviceAccessSubnetID, vars.siteID, vars.vpcID, acctest.ListOfStrings(vars.routeServerPeeringIDs...), keyName, vars.solutionKey, vars.vsanKey, hostCount)
+}
@@ -3,4 +3,45 @@
 
 package evs
 
-func RegisterSweepers() {}
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_evs_environment", sweepEnvironments)
+}
+
+func sweepEnvironments(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EVSClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := evs.NewListEnvironmentsPaginator(conn, &evs.ListEnvironmentsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.EnvironmentSummaries {
+			if v.EnvironmentState == awstypes.EnvironmentStateDeleting || v.EnvironmentState == awstypes.EnvironmentStateDeleted {
+				continue
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newEnvironmentResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.EnvironmentId)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
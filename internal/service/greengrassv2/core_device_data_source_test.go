@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package greengrassv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGreengrassV2CoreDeviceDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Core devices are registered by the Greengrass nucleus software running on the device.
+	thingName := acctest.SkipIfEnvVarNotSet(t, "AWS_GREENGRASSV2_CORE_DEVICE_THING_NAME")
+	dataSourceName := "data.aws_greengrassv2_core_device.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GreengrassV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreDeviceDataSourceConfig_basic(thingName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.MatchResourceAttrRegionalARN(ctx, dataSourceName, names.AttrARN, "greengrass", regexache.MustCompile(fmt.Sprintf("coreDevices:%s$", thingName))),
+					resource.TestCheckResourceAttrSet(dataSourceName, "architecture"),
+					resource.TestCheckResourceAttr(dataSourceName, "core_device_thing_name", thingName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "core_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "last_status_update_timestamp"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "platform"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func testAccCoreDeviceDataSourceConfig_basic(thingName string) string {
+	return fmt.Sprintf(`
+data "aws_greengrassv2_core_device" "test" {
+  core_device_thing_name = %[1]q
+}
+`, thingName)
+}
@@ -0,0 +1,616 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package greengrassv2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/greengrassv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_greengrassv2_deployment", name="Deployment")
+// @Tags(identifierAttribute="arn")
+func resourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeploymentCreate,
+		ReadWithoutTimeout:   resourceDeploymentRead,
+		UpdateWithoutTimeout: resourceDeploymentUpdate,
+		DeleteWithoutTimeout: resourceDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"component_version": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"configuration_update": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"merge": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateFunc:     validation.StringIsJSON,
+										DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+										StateFunc: func(v any) string {
+											json, _ := structure.NormalizeJsonString(v)
+											return json
+										},
+									},
+									"reset": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"run_with": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"posix_user": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"system_resource_limits": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cpus": {
+													Type:         schema.TypeFloat,
+													Optional:     true,
+													ValidateFunc: validation.FloatAtLeast(0),
+												},
+												"memory": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+											},
+										},
+									},
+									"windows_user": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"deployment_policies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_update_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAction: {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.DeploymentComponentUpdatePolicyAction](),
+									},
+									"timeout_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"configuration_validation_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"timeout_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"failure_handling_policy": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.DeploymentFailureHandlingPolicy](),
+						},
+					},
+				},
+			},
+			"iot_job_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iot_job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parent_target_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrTargetARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Client(ctx)
+
+	targetARN := d.Get(names.AttrTargetARN).(string)
+	input := greengrassv2.CreateDeploymentInput{
+		ClientToken: aws.String(sdkid.UniqueId()),
+		Tags:        getTagsIn(ctx),
+		TargetArn:   aws.String(targetARN),
+	}
+
+	if v, ok := d.GetOk("component"); ok && v.(*schema.Set).Len() > 0 {
+		input.Components = expandComponentDeploymentSpecifications(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("deployment_name"); ok {
+		input.DeploymentName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("deployment_policies"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.DeploymentPolicies = expandDeploymentPolicies(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk("parent_target_arn"); ok {
+		input.ParentTargetArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateDeployment(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Greengrass V2 Deployment (%s): %s", targetARN, err)
+	}
+
+	d.SetId(aws.ToString(output.DeploymentId))
+
+	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+	conn := c.GreengrassV2Client(ctx)
+
+	output, err := findDeploymentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && retry.NotFound(err) {
+		log.Printf("[WARN] Greengrass V2 Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Deployment (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, deploymentARN(ctx, c, d.Id()))
+	if err := d.Set("component", flattenComponentDeploymentSpecifications(output.Components)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting component: %s", err)
+	}
+	d.Set("deployment_id", output.DeploymentId)
+	d.Set("deployment_name", output.DeploymentName)
+	if output.DeploymentPolicies != nil {
+		if err := d.Set("deployment_policies", []any{flattenDeploymentPolicies(output.DeploymentPolicies)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting deployment_policies: %s", err)
+		}
+	} else {
+		d.Set("deployment_policies", nil)
+	}
+	d.Set("iot_job_arn", output.IotJobArn)
+	d.Set("iot_job_id", output.IotJobId)
+	d.Set("parent_target_arn", output.ParentTargetArn)
+	d.Set(names.AttrStatus, output.DeploymentStatus)
+	d.Set(names.AttrTargetARN, output.TargetArn)
+
+	return diags
+}
+
+func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Client(ctx)
+
+	// Active deployments must be canceled before they can be deleted.
+	if awstypes.DeploymentStatus(d.Get(names.AttrStatus).(string)) == awstypes.DeploymentStatusActive {
+		log.Printf("[DEBUG] Canceling Greengrass V2 Deployment: %s", d.Id())
+		input := greengrassv2.CancelDeploymentInput{
+			DeploymentId: aws.String(d.Id()),
+		}
+		_, err := conn.CancelDeployment(ctx, &input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return diags
+		}
+
+		if err != nil && !errs.IsA[*awstypes.ConflictException](err) {
+			return sdkdiag.AppendErrorf(diags, "canceling Greengrass V2 Deployment (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Greengrass V2 Deployment: %s", d.Id())
+	input := greengrassv2.DeleteDeploymentInput{
+		DeploymentId: aws.String(d.Id()),
+	}
+	_, err := conn.DeleteDeployment(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Greengrass V2 Deployment (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDeploymentByID(ctx context.Context, conn *greengrassv2.Client, id string) (*greengrassv2.GetDeploymentOutput, error) {
+	input := greengrassv2.GetDeploymentInput{
+		DeploymentId: aws.String(id),
+	}
+
+	output, err := conn.GetDeployment(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// A canceled deployment can't be resumed, and an inactive one has been superseded by a later deployment to the same target.
+	if status := output.DeploymentStatus; status == awstypes.DeploymentStatusCanceled || status == awstypes.DeploymentStatusInactive {
+		return nil, &retry.NotFoundError{
+			Message: string(status),
+		}
+	}
+
+	return output, nil
+}
+
+func deploymentARN(ctx context.Context, c *conns.AWSClient, id string) string {
+	return c.RegionalARN(ctx, "greengrass", "deployments:"+id)
+}
+
+func expandComponentDeploymentSpecifications(tfList []any) map[string]awstypes.ComponentDeploymentSpecification {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]awstypes.ComponentDeploymentSpecification)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.ComponentDeploymentSpecification{}
+
+		if v, ok := tfMap["component_version"].(string); ok && v != "" {
+			apiObject.ComponentVersion = aws.String(v)
+		}
+
+		if v, ok := tfMap["configuration_update"].([]any); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ConfigurationUpdate = expandComponentConfigurationUpdate(v[0].(map[string]any))
+		}
+
+		if v, ok := tfMap["run_with"].([]any); ok && len(v) > 0 && v[0] != nil {
+			apiObject.RunWith = expandComponentRunWith(v[0].(map[string]any))
+		}
+
+		apiObjects[tfMap["component_name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandComponentConfigurationUpdate(tfMap map[string]any) *awstypes.ComponentConfigurationUpdate {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ComponentConfigurationUpdate{}
+
+	if v, ok := tfMap["merge"].(string); ok && v != "" {
+		apiObject.Merge = aws.String(v)
+	}
+
+	if v, ok := tfMap["reset"].([]any); ok && len(v) > 0 {
+		apiObject.Reset = flex.ExpandStringValueList(v)
+	}
+
+	return apiObject
+}
+
+func expandComponentRunWith(tfMap map[string]any) *awstypes.ComponentRunWith {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ComponentRunWith{}
+
+	if v, ok := tfMap["posix_user"].(string); ok && v != "" {
+		apiObject.PosixUser = aws.String(v)
+	}
+
+	if v, ok := tfMap["system_resource_limits"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SystemResourceLimits = expandSystemResourceLimits(v[0].(map[string]any))
+	}
+
+	if v, ok := tfMap["windows_user"].(string); ok && v != "" {
+		apiObject.WindowsUser = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSystemResourceLimits(tfMap map[string]any) *awstypes.SystemResourceLimits {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.SystemResourceLimits{}
+
+	if v, ok := tfMap["cpus"].(float64); ok {
+		apiObject.Cpus = v
+	}
+
+	if v, ok := tfMap["memory"].(int); ok {
+		apiObject.Memory = int64(v)
+	}
+
+	return apiObject
+}
+
+func expandDeploymentPolicies(tfMap map[string]any) *awstypes.DeploymentPolicies {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.DeploymentPolicies{}
+
+	if v, ok := tfMap["component_update_policy"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ComponentUpdatePolicy = expandDeploymentComponentUpdatePolicy(v[0].(map[string]any))
+	}
+
+	if v, ok := tfMap["configuration_validation_policy"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ConfigurationValidationPolicy = expandDeploymentConfigurationValidationPolicy(v[0].(map[string]any))
+	}
+
+	if v, ok := tfMap["failure_handling_policy"].(string); ok && v != "" {
+		apiObject.FailureHandlingPolicy = awstypes.DeploymentFailureHandlingPolicy(v)
+	}
+
+	return apiObject
+}
+
+func expandDeploymentComponentUpdatePolicy(tfMap map[string]any) *awstypes.DeploymentComponentUpdatePolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.DeploymentComponentUpdatePolicy{}
+
+	if v, ok := tfMap[names.AttrAction].(string); ok && v != "" {
+		apiObject.Action = awstypes.DeploymentComponentUpdatePolicyAction(v)
+	}
+
+	if v, ok := tfMap["timeout_in_seconds"].(int); ok && v != 0 {
+		apiObject.TimeoutInSeconds = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandDeploymentConfigurationValidationPolicy(tfMap map[string]any) *awstypes.DeploymentConfigurationValidationPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.DeploymentConfigurationValidationPolicy{}
+
+	if v, ok := tfMap["timeout_in_seconds"].(int); ok && v != 0 {
+		apiObject.TimeoutInSeconds = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenComponentDeploymentSpecifications(apiObjects map[string]awstypes.ComponentDeploymentSpecification) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for name, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"component_name":    name,
+			"component_version": aws.ToString(apiObject.ComponentVersion),
+		}
+
+		if v := apiObject.ConfigurationUpdate; v != nil {
+			tfMap["configuration_update"] = []any{flattenComponentConfigurationUpdate(v)}
+		}
+
+		if v := apiObject.RunWith; v != nil {
+			tfMap["run_with"] = []any{flattenComponentRunWith(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenComponentConfigurationUpdate(apiObject *awstypes.ComponentConfigurationUpdate) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{}
+
+	if v := apiObject.Merge; v != nil {
+		tfMap["merge"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Reset; v != nil {
+		tfMap["reset"] = v
+	}
+
+	return tfMap
+}
+
+func flattenComponentRunWith(apiObject *awstypes.ComponentRunWith) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{}
+
+	if v := apiObject.PosixUser; v != nil {
+		tfMap["posix_user"] = aws.ToString(v)
+	}
+
+	if v := apiObject.SystemResourceLimits; v != nil {
+		tfMap["system_resource_limits"] = []any{map[string]any{
+			"cpus":   v.Cpus,
+			"memory": v.Memory,
+		}}
+	}
+
+	if v := apiObject.WindowsUser; v != nil {
+		tfMap["windows_user"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenDeploymentPolicies(apiObject *awstypes.DeploymentPolicies) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"failure_handling_policy": string(apiObject.FailureHandlingPolicy),
+	}
+
+	if v := apiObject.ComponentUpdatePolicy; v != nil {
+		tfMap["component_update_policy"] = []any{map[string]any{
+			names.AttrAction:     string(v.Action),
+			"timeout_in_seconds": aws.ToInt32(v.TimeoutInSeconds),
+		}}
+	}
+
+	if v := apiObject.ConfigurationValidationPolicy; v != nil {
+		tfMap["configuration_validation_policy"] = []any{map[string]any{
+			"timeout_in_seconds": aws.ToInt32(v.TimeoutInSeconds),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,13 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package greengrassv2
+
+// Exports for use in tests only.
+var (
+	ResourceComponentVersion = resourceComponentVersion
+	ResourceDeployment       = resourceDeployment
+
+	FindComponentVersionByARN = findComponentVersionByARN
+	FindDeploymentByID        = findDeploymentByID
+)
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package greengrassv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/greengrassv2/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_greengrassv2_component_version", sweepComponentVersions, "aws_greengrassv2_deployment")
+	awsv2.Register("aws_greengrassv2_deployment", sweepDeployments)
+}
+
+func sweepComponentVersions(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.GreengrassV2Client(ctx)
+	input := greengrassv2.ListComponentsInput{
+		Scope: awstypes.ComponentVisibilityScopePrivate,
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := greengrassv2.NewListComponentsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Components {
+			input := greengrassv2.ListComponentVersionsInput{
+				Arn: v.Arn,
+			}
+
+			pages := greengrassv2.NewListComponentVersionsPaginator(conn, &input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.ComponentVersions {
+					r := resourceComponentVersion()
+					d := r.Data(nil)
+					d.SetId(aws.ToString(v.Arn))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepDeployments(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.GreengrassV2Client(ctx)
+	input := greengrassv2.ListDeploymentsInput{
+		HistoryFilter: awstypes.DeploymentHistoryFilterAll,
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := greengrassv2.NewListDeploymentsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Deployments {
+			r := resourceDeployment()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.DeploymentId))
+			d.Set(names.AttrStatus, v.DeploymentStatus)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,459 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_groundstation_dataflow_endpoint_group", name="Dataflow Endpoint Group")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newDataflowEndpointGroupResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &dataflowEndpointGroupResource{}, nil
+}
+
+type dataflowEndpointGroupResource struct {
+	framework.ResourceWithModel[dataflowEndpointGroupResourceModel]
+	framework.WithImportByID
+}
+
+func (r *dataflowEndpointGroupResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	contactPassDurationAttribute := func() schema.Int32Attribute {
+		return schema.Int32Attribute{
+			Optional: true,
+			Computed: true,
+			Validators: []validator.Int32{
+				int32validator.Between(120, 21600),
+			},
+			PlanModifiers: []planmodifier.Int32{
+				int32planmodifier.RequiresReplace(),
+				int32planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	requiredStringAttribute := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+	optionalInt32Attribute := func() schema.Int32Attribute {
+		return schema.Int32Attribute{
+			Optional: true,
+			PlanModifiers: []planmodifier.Int32{
+				int32planmodifier.RequiresReplace(),
+			},
+		}
+	}
+	requiredInt32Attribute := func() schema.Int32Attribute {
+		return schema.Int32Attribute{
+			Required: true,
+			Validators: []validator.Int32{
+				int32validator.Between(0, 65535),
+			},
+			PlanModifiers: []planmodifier.Int32{
+				int32planmodifier.RequiresReplace(),
+			},
+		}
+	}
+	singleBlockValidators := func(required bool) []validator.List {
+		v := []validator.List{
+			listvalidator.SizeAtMost(1),
+		}
+		if required {
+			v = append(v, listvalidator.IsRequired())
+		}
+		return v
+	}
+	socketAddressBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[socketAddressModel](ctx),
+			Validators: singleBlockValidators(true),
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrName: requiredStringAttribute(),
+					names.AttrPort: requiredInt32Attribute(),
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:                        framework.ARNAttributeComputedOnly(),
+			"contact_post_pass_duration_seconds": contactPassDurationAttribute(),
+			"contact_pre_pass_duration_seconds":  contactPassDurationAttribute(),
+			names.AttrID:                         framework.IDAttribute(),
+			names.AttrTags:                       tftags.TagsAttribute(),
+			names.AttrTagsAll:                    tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"endpoint_details": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[endpointDetailsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 500),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aws_ground_station_agent_endpoint": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[awsGroundStationAgentEndpointModel](ctx),
+							Validators: singleBlockValidators(false),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: requiredStringAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"egress_address": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[connectionDetailsModel](ctx),
+										Validators: singleBlockValidators(true),
+										PlanModifiers: []planmodifier.List{
+											listplanmodifier.RequiresReplace(),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"mtu": optionalInt32Attribute(),
+											},
+											Blocks: map[string]schema.Block{
+												"socket_address": socketAddressBlock(),
+											},
+										},
+									},
+									"ingress_address": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[rangedConnectionDetailsModel](ctx),
+										Validators: singleBlockValidators(true),
+										PlanModifiers: []planmodifier.List{
+											listplanmodifier.RequiresReplace(),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"mtu": optionalInt32Attribute(),
+											},
+											Blocks: map[string]schema.Block{
+												"socket_address": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[rangedSocketAddressModel](ctx),
+													Validators: singleBlockValidators(true),
+													PlanModifiers: []planmodifier.List{
+														listplanmodifier.RequiresReplace(),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrName: requiredStringAttribute(),
+														},
+														Blocks: map[string]schema.Block{
+															"port_range": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[integerRangeModel](ctx),
+																Validators: singleBlockValidators(true),
+																PlanModifiers: []planmodifier.List{
+																	listplanmodifier.RequiresReplace(),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		"maximum": requiredInt32Attribute(),
+																		"minimum": requiredInt32Attribute(),
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						names.AttrEndpoint: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataflowEndpointModel](ctx),
+							Validators: singleBlockValidators(false),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"mtu":          optionalInt32Attribute(),
+									names.AttrName: requiredStringAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrAddress: socketAddressBlock(),
+								},
+							},
+						},
+						"security_details": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[securityDetailsModel](ctx),
+							Validators: singleBlockValidators(false),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrRoleARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									names.AttrSecurityGroupIDs: schema.SetAttribute{
+										CustomType: fwtypes.SetOfStringType,
+										Required:   true,
+										PlanModifiers: []planmodifier.Set{
+											setplanmodifier.RequiresReplace(),
+										},
+									},
+									names.AttrSubnetIDs: schema.SetAttribute{
+										CustomType: fwtypes.SetOfStringType,
+										Required:   true,
+										PlanModifiers: []planmodifier.Set{
+											setplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *dataflowEndpointGroupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data dataflowEndpointGroupResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	var input groundstation.CreateDataflowEndpointGroupInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateDataflowEndpointGroup(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	id := aws.ToString(output.DataflowEndpointGroupId)
+	dataflowEndpointGroup, err := findDataflowEndpointGroupByID(ctx, conn, id)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, dataflowEndpointGroup.DataflowEndpointGroupArn)
+	data.ContactPostPassDurationSeconds = fwflex.Int32ToFramework(ctx, dataflowEndpointGroup.ContactPostPassDurationSeconds)
+	data.ContactPrePassDurationSeconds = fwflex.Int32ToFramework(ctx, dataflowEndpointGroup.ContactPrePassDurationSeconds)
+	data.ID = types.StringValue(id)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *dataflowEndpointGroupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data dataflowEndpointGroupResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	id := data.ID.ValueString()
+	output, err := findDataflowEndpointGroupByID(ctx, conn, id)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("DataflowEndpointGroup")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	// The API returns the endpoints as "EndpointsDetails".
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output.EndpointsDetails, &data.EndpointDetails))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *dataflowEndpointGroupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data dataflowEndpointGroupResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags only.
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *dataflowEndpointGroupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data dataflowEndpointGroupResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	id := data.ID.ValueString()
+	input := groundstation.DeleteDataflowEndpointGroupInput{
+		DataflowEndpointGroupId: aws.String(id),
+	}
+	_, err := conn.DeleteDataflowEndpointGroup(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func findDataflowEndpointGroupByID(ctx context.Context, conn *groundstation.Client, id string) (*groundstation.GetDataflowEndpointGroupOutput, error) {
+	input := groundstation.GetDataflowEndpointGroupInput{
+		DataflowEndpointGroupId: aws.String(id),
+	}
+
+	output, err := conn.GetDataflowEndpointGroup(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	return output, nil
+}
+
+type dataflowEndpointGroupResourceModel struct {
+	framework.WithRegionModel
+	ARN                            types.String                                          `tfsdk:"arn"`
+	ContactPostPassDurationSeconds types.Int32                                           `tfsdk:"contact_post_pass_duration_seconds"`
+	ContactPrePassDurationSeconds  types.Int32                                           `tfsdk:"contact_pre_pass_duration_seconds"`
+	EndpointDetails                fwtypes.ListNestedObjectValueOf[endpointDetailsModel] `tfsdk:"endpoint_details"`
+	ID                             types.String                                          `tfsdk:"id"`
+	Tags                           tftags.Map                                            `tfsdk:"tags"`
+	TagsAll                        tftags.Map                                            `tfsdk:"tags_all"`
+}
+
+type endpointDetailsModel struct {
+	AWSGroundStationAgentEndpoint fwtypes.ListNestedObjectValueOf[awsGroundStationAgentEndpointModel] `tfsdk:"aws_ground_station_agent_endpoint"`
+	Endpoint                      fwtypes.ListNestedObjectValueOf[dataflowEndpointModel]              `tfsdk:"endpoint"`
+	SecurityDetails               fwtypes.ListNestedObjectValueOf[securityDetailsModel]               `tfsdk:"security_details"`
+}
+
+type awsGroundStationAgentEndpointModel struct {
+	EgressAddress  fwtypes.ListNestedObjectValueOf[connectionDetailsModel]       `tfsdk:"egress_address"`
+	IngressAddress fwtypes.ListNestedObjectValueOf[rangedConnectionDetailsModel] `tfsdk:"ingress_address"`
+	Name           types.String                                                  `tfsdk:"name"`
+}
+
+type connectionDetailsModel struct {
+	MTU           types.Int32                                         `tfsdk:"mtu"`
+	SocketAddress fwtypes.ListNestedObjectValueOf[socketAddressModel] `tfsdk:"socket_address"`
+}
+
+type rangedConnectionDetailsModel struct {
+	MTU           types.Int32                                               `tfsdk:"mtu"`
+	SocketAddress fwtypes.ListNestedObjectValueOf[rangedSocketAddressModel] `tfsdk:"socket_address"`
+}
+
+type socketAddressModel struct {
+	Name types.String `tfsdk:"name"`
+	Port types.Int32  `tfsdk:"port"`
+}
+
+type rangedSocketAddressModel struct {
+	Name      types.String                                       `tfsdk:"name"`
+	PortRange fwtypes.ListNestedObjectValueOf[integerRangeModel] `tfsdk:"port_range"`
+}
+
+type integerRangeModel struct {
+	Maximum types.Int32 `tfsdk:"maximum"`
+	Minimum types.Int32 `tfsdk:"minimum"`
+}
+
+type dataflowEndpointModel struct {
+	Address fwtypes.ListNestedObjectValueOf[socketAddressModel] `tfsdk:"address"`
+	MTU     types.Int32                                         `tfsdk:"mtu"`
+	Name    types.String                                        `tfsdk:"name"`
+}
+
+type securityDetailsModel struct {
+	RoleARN          fwtypes.ARN         `tfsdk:"role_arn"`
+	SecurityGroupIDs fwtypes.SetOfString `tfsdk:"security_group_ids"`
+	SubnetIDs        fwtypes.SetOfString `tfsdk:"subnet_ids"`
+}
@@ -0,0 +1,15 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+// Exports for use in tests only.
+var (
+	ResourceConfig                = newConfigResource
+	ResourceDataflowEndpointGroup = newDataflowEndpointGroupResource
+	ResourceMissionProfile        = newMissionProfileResource
+
+	FindConfigByTwoPartKey        = findConfigByTwoPartKey
+	FindDataflowEndpointGroupByID = findDataflowEndpointGroupByID
+	FindMissionProfileByID        = findMissionProfileByID
+)
@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	fhirImportJobPollInterval     = 30 * time.Second
+	fhirImportJobProgressInterval = 2 * time.Minute
+)
+
+// @Action(aws_healthlake_start_fhir_import_job, name="Start FHIR Import Job")
+func newStartFHIRImportJobAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startFHIRImportJobAction{}, nil
+}
+
+var (
+	_ action.Action = (*startFHIRImportJobAction)(nil)
+)
+
+type startFHIRImportJobAction struct {
+	framework.ActionWithModel[startFHIRImportJobActionModel]
+}
+
+type startFHIRImportJobActionModel struct {
+	framework.WithRegionModel
+	DataAccessRoleARN fwtypes.ARN                                  `tfsdk:"data_access_role_arn"`
+	DatastoreID       types.String                                 `tfsdk:"datastore_id"`
+	InputS3URI        types.String                                 `tfsdk:"input_s3_uri"`
+	JobName           types.String                                 `tfsdk:"job_name"`
+	OutputKMSKeyID    types.String                                 `tfsdk:"output_kms_key_id"`
+	OutputS3URI       types.String                                 `tfsdk:"output_s3_uri"`
+	Timeout           types.Int64                                  `tfsdk:"timeout"`
+	ValidationLevel   fwtypes.StringEnum[awstypes.ValidationLevel] `tfsdk:"validation_level"`
+}
+
+func (a *startFHIRImportJobAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an AWS HealthLake FHIR import job and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"data_access_role_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the IAM role that grants HealthLake access to the input and output S3 locations.",
+				Required:    true,
+			},
+			"datastore_id": schema.StringAttribute{
+				Description: "The ID of the FHIR data store to import into.",
+				Required:    true,
+			},
+			"input_s3_uri": schema.StringAttribute{
+				Description: "The S3 location of the FHIR data to import (e.g., s3://bucket-name/prefix/).",
+				Required:    true,
+			},
+			"job_name": schema.StringAttribute{
+				Description: "The name of the import job.",
+				Optional:    true,
+			},
+			"output_kms_key_id": schema.StringAttribute{
+				Description: "The KMS key used to encrypt the import job output.",
+				Required:    true,
+			},
+			"output_s3_uri": schema.StringAttribute{
+				Description: "The S3 location where the import job writes its output.",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Maximum time in seconds to wait for the import job to complete. Defaults to 3600 seconds (60 minutes).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(60, 86400),
+				},
+			},
+			"validation_level": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.ValidationLevel](),
+				Description: "The validation level of the import job.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *startFHIRImportJobAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startFHIRImportJobActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().HealthLakeClient(ctx)
+
+	datastoreID := config.DatastoreID.ValueString()
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting FHIR import job action", map[string]any{
+		"datastore_id":    datastoreID,
+		"input_s3_uri":    config.InputS3URI.ValueString(),
+		"timeout_seconds": int64(timeout.Seconds()),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting FHIR import job for data store %s...", datastoreID),
+	})
+
+	input := healthlake.StartFHIRImportJobInput{
+		ClientToken:       aws.String(sdkid.UniqueId()),
+		DataAccessRoleArn: config.DataAccessRoleARN.ValueStringPointer(),
+		DatastoreId:       aws.String(datastoreID),
+		InputDataConfig: &awstypes.InputDataConfigMemberS3Uri{
+			Value: config.InputS3URI.ValueString(),
+		},
+		JobOutputDataConfig: &awstypes.OutputDataConfigMemberS3Configuration{
+			Value: awstypes.S3Configuration{
+				KmsKeyId: config.OutputKMSKeyID.ValueStringPointer(),
+				S3Uri:    config.OutputS3URI.ValueStringPointer(),
+			},
+		},
+	}
+
+	if !config.JobName.IsNull() {
+		input.JobName = config.JobName.ValueStringPointer()
+	}
+
+	if !config.ValidationLevel.IsNull() {
+		input.ValidationLevel = config.ValidationLevel.ValueEnum()
+	}
+
+	output, err := conn.StartFHIRImportJob(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start FHIR Import Job",
+			fmt.Sprintf("Could not start FHIR import job for data store %s: %s", datastoreID, err),
+		)
+		return
+	}
+
+	jobID := aws.ToString(output.JobId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("FHIR import job %s started, waiting for completion...", jobID),
+	})
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.ImportJobProperties], error) {
+		input := healthlake.DescribeFHIRImportJobInput{
+			DatastoreId: aws.String(datastoreID),
+			JobId:       aws.String(jobID),
+		}
+		output, err := conn.DescribeFHIRImportJob(ctx, &input)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.ImportJobProperties]{}, fmt.Errorf("describe FHIR import job: %w", err)
+		}
+		if output.ImportJobProperties == nil {
+			return actionwait.FetchResult[*awstypes.ImportJobProperties]{}, fmt.Errorf("FHIR import job %s not found", jobID)
+		}
+		return actionwait.FetchResult[*awstypes.ImportJobProperties]{Status: actionwait.Status(output.ImportJobProperties.JobStatus), Value: output.ImportJobProperties}, nil
+	}, actionwait.Options[*awstypes.ImportJobProperties]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(fhirImportJobPollInterval),
+		ProgressInterval: fhirImportJobProgressInterval,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobStatusCompleted),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobStatusSubmitted),
+			actionwait.Status(awstypes.JobStatusQueued),
+			actionwait.Status(awstypes.JobStatusInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobStatusCompletedWithErrors),
+			actionwait.Status(awstypes.JobStatusFailed),
+			actionwait.Status(awstypes.JobStatusCancelSubmitted),
+			actionwait.Status(awstypes.JobStatusCancelInProgress),
+			actionwait.Status(awstypes.JobStatusCancelCompleted),
+			actionwait.Status(awstypes.JobStatusCancelFailed),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("FHIR import job %s is currently %s", jobID, fr.Status)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for FHIR Import Job",
+				fmt.Sprintf("FHIR import job %s did not complete within %v", jobID, timeout),
+			)
+		} else if errors.As(err, &failureErr) {
+			message := ""
+			if fr.Value != nil {
+				message = aws.ToString(fr.Value.Message)
+			}
+			detail := fmt.Sprintf("FHIR import job %s failed with status %s: %s", jobID, failureErr.Status, message)
+			if fr.Value != nil {
+				if report := fr.Value.JobProgressReport; report != nil {
+					detail += fmt.Sprintf("\n\nResources scanned: %d, imported: %d, with errors: %d",
+						aws.ToInt64(report.TotalNumberOfResourcesScanned), aws.ToInt64(report.TotalNumberOfResourcesImported), aws.ToInt64(report.TotalNumberOfResourcesWithCustomerError))
+				}
+				if v, ok := fr.Value.JobOutputDataConfig.(*awstypes.OutputDataConfigMemberS3Configuration); ok {
+					detail += fmt.Sprintf("\n\nError details were written to %s", aws.ToString(v.Value.S3Uri))
+				}
+			}
+			resp.Diagnostics.AddError(
+				"FHIR Import Job Failed",
+				detail,
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected FHIR Import Job Status",
+				fmt.Sprintf("FHIR import job %s entered unexpected status: %s", jobID, unexpectedErr.Status),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for FHIR Import Job",
+				fmt.Sprintf("Error while waiting for FHIR import job %s: %s", jobID, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("FHIR import job %s finished with status %s", jobID, fr.Status)})
+	tflog.Info(ctx, "FHIR import job completed", map[string]any{
+		"datastore_id": datastoreID,
+		"job_id":       jobID,
+		"job_status":   fr.Status,
+	})
+}
@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccHealthLakeStartFHIRImportJobAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartFHIRImportJobActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRImportJobStatus(ctx, resourceName, rName, "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFHIRImportJobStatus(ctx context.Context, n, jobName string, expectedStatuses ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).HealthLakeClient(ctx)
+
+		input := healthlake.ListFHIRImportJobsInput{
+			DatastoreId: aws.String(rs.Primary.ID),
+			JobName:     aws.String(jobName),
+		}
+		output, err := conn.ListFHIRImportJobs(ctx, &input)
+
+		if err != nil {
+			return fmt.Errorf("error listing FHIR import jobs for data store %s: %w", rs.Primary.ID, err)
+		}
+
+		if len(output.ImportJobPropertiesList) == 0 {
+			return fmt.Errorf("FHIR import job %s not found", jobName)
+		}
+
+		actualStatus := string(output.ImportJobPropertiesList[0].JobStatus)
+		if slices.Contains(expectedStatuses, actualStatus) {
+			return nil
+		}
+
+		return fmt.Errorf("expected FHIR import job %s status to be one of %v, got %s", jobName, expectedStatuses, actualStatus)
+	}
+}
+
+func testAccStartFHIRImportJobActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "input/patient.ndjson"
+  source = "test-fixtures/patient.ndjson"
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "healthlake.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect   = "Allow"
+        Action   = ["s3:GetObject", "s3:ListBucket", "s3:PutObject", "s3:GetBucketPublicAccessBlock", "s3:GetEncryptionConfiguration"]
+        Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+      },
+      {
+        Effect   = "Allow"
+        Action   = ["kms:DescribeKey", "kms:GenerateDataKey", "kms:Decrypt"]
+        Resource = [aws_kms_key.test.arn]
+      },
+    ]
+  })
+}
+
+resource "aws_healthlake_fhir_datastore" "test" {
+  name                   = %[1]q
+  datastore_type_version = "R4"
+}
+
+action "aws_healthlake_start_fhir_import_job" "test" {
+  config {
+    datastore_id         = aws_healthlake_fhir_datastore.test.id
+    data_access_role_arn = aws_iam_role.test.arn
+    input_s3_uri         = "s3://${aws_s3_bucket.test.bucket}/input/"
+    job_name             = %[1]q
+    output_kms_key_id    = aws_kms_key.test.arn
+    output_s3_uri        = "s3://${aws_s3_bucket.test.bucket}/output/"
+    timeout              = 3600
+  }
+}
+
+resource "terraform_data" "test" {
+  triggers_replace = [
+    aws_s3_object.test.etag
+  ]
+
+  input = "completed"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_healthlake_start_fhir_import_job.test]
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName)
+}
@@ -0,0 +1,474 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_kinesisvideo_edge_configuration", name="Edge Configuration")
+func newEdgeConfigurationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &edgeConfigurationResource{}
+
+	r.SetDefaultCreateTimeout(15 * time.Minute)
+	r.SetDefaultUpdateTimeout(15 * time.Minute)
+	r.SetDefaultDeleteTimeout(15 * time.Minute)
+
+	return r, nil
+}
+
+type edgeConfigurationResource struct {
+	framework.ResourceWithModel[edgeConfigurationResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *edgeConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	scheduleConfigBlock := func(required bool) schema.ListNestedBlock {
+		validators := []validator.List{
+			listvalidator.SizeAtMost(1),
+		}
+		if required {
+			validators = append(validators, listvalidator.IsRequired())
+		}
+
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[scheduleConfigModel](ctx),
+			Validators: validators,
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"duration_in_seconds": schema.Int32Attribute{
+						Required: true,
+						Validators: []validator.Int32{
+							int32validator.Between(60, 3600),
+						},
+					},
+					names.AttrScheduleExpression: schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrStreamARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sync_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SyncStatus](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"edge_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[edgeConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"hub_device_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"deletion_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[deletionConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"delete_after_upload": schema.BoolAttribute{
+										Optional: true,
+									},
+									"edge_retention_in_hours": schema.Int32Attribute{
+										Optional: true,
+										Validators: []validator.Int32{
+											int32validator.Between(1, 720),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"local_size_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[localSizeConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"max_local_media_size_in_mb": schema.Int32Attribute{
+													Optional: true,
+													Validators: []validator.Int32{
+														int32validator.Between(64, 2000000),
+													},
+												},
+												"strategy_on_full_size": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.StrategyOnFullSize](),
+													Optional:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"recorder_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recorderConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"media_source_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[mediaSourceConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"media_uri_secret_arn": schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+												"media_uri_type": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.MediaUriType](),
+													Required:   true,
+												},
+											},
+										},
+									},
+									"schedule_config": scheduleConfigBlock(false),
+								},
+							},
+						},
+						"uploader_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[uploaderConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"schedule_config": scheduleConfigBlock(true),
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *edgeConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data edgeConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	streamARN := data.StreamARN.ValueString()
+	var input kinesisvideo.StartEdgeConfigurationUpdateInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.StartEdgeConfigurationUpdate(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Kinesis Video Edge Configuration (%s)", streamARN), err.Error())
+
+		return
+	}
+
+	output, err := waitEdgeConfigurationInSync(ctx, conn, streamARN, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrStreamARN), data.StreamARN) // Set 'stream_arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Kinesis Video Edge Configuration (%s) create", streamARN), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.SyncStatus = fwtypes.StringEnumValue(output.SyncStatus)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *edgeConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data edgeConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	streamARN := data.StreamARN.ValueString()
+	output, err := findEdgeConfigurationByStreamARN(ctx, conn, streamARN)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Kinesis Video Edge Configuration (%s)", streamARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *edgeConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new edgeConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	streamARN := new.StreamARN.ValueString()
+	var input kinesisvideo.StartEdgeConfigurationUpdateInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.StartEdgeConfigurationUpdate(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Kinesis Video Edge Configuration (%s)", streamARN), err.Error())
+
+		return
+	}
+
+	output, err := waitEdgeConfigurationInSync(ctx, conn, streamARN, r.UpdateTimeout(ctx, new.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Kinesis Video Edge Configuration (%s) update", streamARN), err.Error())
+
+		return
+	}
+
+	new.SyncStatus = fwtypes.StringEnumValue(output.SyncStatus)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *edgeConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data edgeConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	streamARN := data.StreamARN.ValueString()
+	input := kinesisvideo.DeleteEdgeConfigurationInput{
+		StreamARN: aws.String(streamARN),
+	}
+	_, err := conn.DeleteEdgeConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.IsA[*awstypes.StreamEdgeConfigurationNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Kinesis Video Edge Configuration (%s)", streamARN), err.Error())
+
+		return
+	}
+
+	if _, err := waitEdgeConfigurationDeleted(ctx, conn, streamARN, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Kinesis Video Edge Configuration (%s) delete", streamARN), err.Error())
+
+		return
+	}
+}
+
+func (r *edgeConfigurationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrStreamARN), request, response)
+}
+
+func findEdgeConfigurationByStreamARN(ctx context.Context, conn *kinesisvideo.Client, arn string) (*kinesisvideo.DescribeEdgeConfigurationOutput, error) {
+	input := kinesisvideo.DescribeEdgeConfigurationInput{
+		StreamARN: aws.String(arn),
+	}
+
+	return findEdgeConfiguration(ctx, conn, &input)
+}
+
+func findEdgeConfiguration(ctx context.Context, conn *kinesisvideo.Client, input *kinesisvideo.DescribeEdgeConfigurationInput) (*kinesisvideo.DescribeEdgeConfigurationOutput, error) {
+	output, err := conn.DescribeEdgeConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.IsA[*awstypes.StreamEdgeConfigurationNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EdgeConfig == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusEdgeConfiguration(conn *kinesisvideo.Client, arn string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findEdgeConfigurationByStreamARN(ctx, conn, arn)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.SyncStatus), nil
+	}
+}
+
+func waitEdgeConfigurationInSync(ctx context.Context, conn *kinesisvideo.Client, arn string, timeout time.Duration) (*kinesisvideo.DescribeEdgeConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SyncStatusSyncing, awstypes.SyncStatusAcknowledged),
+		Target:  enum.Slice(awstypes.SyncStatusInSync),
+		Refresh: statusEdgeConfiguration(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kinesisvideo.DescribeEdgeConfigurationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailedStatusDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEdgeConfigurationDeleted(ctx context.Context, conn *kinesisvideo.Client, arn string, timeout time.Duration) (*kinesisvideo.DescribeEdgeConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SyncStatusDeleting, awstypes.SyncStatusDeletingAcknowledged, awstypes.SyncStatusInSync),
+		Target:  []string{},
+		Refresh: statusEdgeConfiguration(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kinesisvideo.DescribeEdgeConfigurationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailedStatusDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type edgeConfigurationResourceModel struct {
+	framework.WithRegionModel
+	EdgeConfig fwtypes.ListNestedObjectValueOf[edgeConfigModel] `tfsdk:"edge_config"`
+	StreamARN  fwtypes.ARN                                      `tfsdk:"stream_arn"`
+	SyncStatus fwtypes.StringEnum[awstypes.SyncStatus]          `tfsdk:"sync_status"`
+	Timeouts   timeouts.Value                                   `tfsdk:"timeouts"`
+}
+
+type edgeConfigModel struct {
+	DeletionConfig fwtypes.ListNestedObjectValueOf[deletionConfigModel] `tfsdk:"deletion_config"`
+	HubDeviceARN   fwtypes.ARN                                          `tfsdk:"hub_device_arn"`
+	RecorderConfig fwtypes.ListNestedObjectValueOf[recorderConfigModel] `tfsdk:"recorder_config"`
+	UploaderConfig fwtypes.ListNestedObjectValueOf[uploaderConfigModel] `tfsdk:"uploader_config"`
+}
+
+type deletionConfigModel struct {
+	DeleteAfterUpload    types.Bool                                            `tfsdk:"delete_after_upload"`
+	EdgeRetentionInHours types.Int32                                           `tfsdk:"edge_retention_in_hours"`
+	LocalSizeConfig      fwtypes.ListNestedObjectValueOf[localSizeConfigModel] `tfsdk:"local_size_config"`
+}
+
+type localSizeConfigModel struct {
+	MaxLocalMediaSizeInMB types.Int32                                     `tfsdk:"max_local_media_size_in_mb"`
+	StrategyOnFullSize    fwtypes.StringEnum[awstypes.StrategyOnFullSize] `tfsdk:"strategy_on_full_size"`
+}
+
+type recorderConfigModel struct {
+	MediaSourceConfig fwtypes.ListNestedObjectValueOf[mediaSourceConfigModel] `tfsdk:"media_source_config"`
+	ScheduleConfig    fwtypes.ListNestedObjectValueOf[scheduleConfigModel]    `tfsdk:"schedule_config"`
+}
+
+type mediaSourceConfigModel struct {
+	MediaURISecretARN fwtypes.ARN                               `tfsdk:"media_uri_secret_arn"`
+	MediaURIType      fwtypes.StringEnum[awstypes.MediaUriType] `tfsdk:"media_uri_type"`
+}
+
+type scheduleConfigModel struct {
+	DurationInSeconds  types.Int32  `tfsdk:"duration_in_seconds"`
+	ScheduleExpression types.String `tfsdk:"schedule_expression"`
+}
+
+type uploaderConfigModel struct {
+	ScheduleConfig fwtypes.ListNestedObjectValueOf[scheduleConfigModel] `tfsdk:"schedule_config"`
+}
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfkinesisvideo "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisvideo"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKinesisVideoEdgeConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Edge configurations require an AWS IoT Greengrass core device running the Kinesis Video Edge Agent.
+	hubDeviceARN := acctest.SkipIfEnvVarNotSet(t, "AWS_KINESISVIDEO_EDGE_HUB_DEVICE_ARN")
+	mediaURISecretARN := acctest.SkipIfEnvVarNotSet(t, "AWS_KINESISVIDEO_EDGE_MEDIA_URI_SECRET_ARN")
+	var output kinesisvideo.DescribeEdgeConfigurationOutput
+	resourceName := "aws_kinesisvideo_edge_configuration.test"
+	streamResourceName := "aws_kinesis_video_stream.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEdgeConfigurationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEdgeConfigurationConfig_basic(rName, hubDeviceARN, mediaURISecretARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEdgeConfigurationExists(ctx, t, resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "edge_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "edge_config.0.hub_device_arn", hubDeviceARN),
+					resource.TestCheckResourceAttr(resourceName, "edge_config.0.recorder_config.0.media_source_config.0.media_uri_type", "RTSP_URI"),
+					resource.TestCheckResourceAttr(resourceName, "edge_config.0.deletion_config.0.edge_retention_in_hours", "24"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrStreamARN, streamResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "sync_status", "IN_SYNC"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrStreamARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrStreamARN,
+				ImportStateVerifyIgnore:              []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccCheckEdgeConfigurationExists(ctx context.Context, t *testing.T, n string, v *kinesisvideo.DescribeEdgeConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).KinesisVideoClient(ctx)
+
+		output, err := tfkinesisvideo.FindEdgeConfigurationByStreamARN(ctx, conn, rs.Primary.Attributes[names.AttrStreamARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckEdgeConfigurationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).KinesisVideoClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kinesisvideo_edge_configuration" {
+				continue
+			}
+
+			_, err := tfkinesisvideo.FindEdgeConfigurationByStreamARN(ctx, conn, rs.Primary.Attributes[names.AttrStreamARN])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Kinesis Video Edge Configuration %s still exists", rs.Primary.Attributes[names.AttrStreamARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccEdgeConfigurationConfig_basic(rName, hubDeviceARN, mediaURISecretARN string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name                    = %[1]q
+  data_retention_in_hours = 24
+}
+
+resource "aws_kinesisvideo_edge_configuration" "test" {
+  stream_arn = aws_kinesis_video_stream.test.arn
+
+  edge_config {
+    hub_device_arn = %[2]q
+
+    recorder_config {
+      media_source_config {
+        media_uri_secret_arn = %[3]q
+        media_uri_type       = "RTSP_URI"
+      }
+    }
+
+    uploader_config {
+      schedule_config {
+        duration_in_seconds = 60
+        schedule_expression = "0 0/5 * * * ?"
+      }
+    }
+
+    deletion_config {
+      delete_after_upload     = true
+      edge_retention_in_hours = 24
+
+      local_size_config {
+        max_local_media_size_in_mb = 1024
+        strategy_on_full_size      = "DELETE_OLDEST_MEDIA"
+      }
+    }
+  }
+}
+`, rName, hubDeviceARN, mediaURISecretARN)
+}
@@ -5,7 +5,17 @@ package kinesisvideo
 
 // Exports for use in tests only.
 var (
-	ResourceStream = resourceStream
+	ResourceEdgeConfiguration            = newEdgeConfigurationResource
+	ResourceImageGenerationConfiguration = newImageGenerationConfigurationResource
+	ResourceMediaStorageConfiguration    = newMediaStorageConfigurationResource
+	ResourceNotificationConfiguration    = newNotificationConfigurationResource
+	ResourceSignalingChannel             = newSignalingChannelResource
+	ResourceStream                       = resourceStream
 
-	FindStreamByARN = findStreamByARN
+	FindEdgeConfigurationByStreamARN            = findEdgeConfigurationByStreamARN
+	FindImageGenerationConfigurationByStreamARN = findImageGenerationConfigurationByStreamARN
+	FindMediaStorageConfigurationByChannelARN   = findMediaStorageConfigurationByChannelARN
+	FindNotificationConfigurationByStreamARN    = findNotificationConfigurationByStreamARN
+	FindSignalingChannelByARN                   = findSignalingChannelByARN
+	FindStreamByARN                             = findStreamByARN
 )
@@ -0,0 +1,315 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_kinesisvideo_image_generation_configuration", name="Image Generation Configuration")
+func newImageGenerationConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &imageGenerationConfigurationResource{}
+
+	return r, nil
+}
+
+type imageGenerationConfigurationResource struct {
+	framework.ResourceWithModel[imageGenerationConfigurationResourceModel]
+}
+
+func (r *imageGenerationConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrFormat: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Format](),
+				Required:   true,
+			},
+			"format_config": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"height_pixels": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 2160),
+				},
+			},
+			"image_selector_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ImageSelectorType](),
+				Required:   true,
+			},
+			"sampling_interval": schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(200),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConfigurationStatus](),
+				Required:   true,
+			},
+			names.AttrStreamARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"width_pixels": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 3840),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"destination_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[imageGenerationDestinationConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"destination_region": schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrURI: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *imageGenerationConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data imageGenerationConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	streamARN := data.StreamARN.ValueString()
+	var configuration awstypes.ImageGenerationConfiguration
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &configuration)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := kinesisvideo.UpdateImageGenerationConfigurationInput{
+		ImageGenerationConfiguration: &configuration,
+		StreamARN:                    aws.String(streamARN),
+	}
+
+	_, err := conn.UpdateImageGenerationConfiguration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Kinesis Video Image Generation Configuration (%s)", streamARN), err.Error())
+
+		return
+	}
+
+	output, err := findImageGenerationConfigurationByStreamARN(ctx, conn, streamARN)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Kinesis Video Image Generation Configuration (%s)", streamARN), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.FormatConfig, &data.FormatConfig)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *imageGenerationConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data imageGenerationConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	streamARN := data.StreamARN.ValueString()
+	output, err := findImageGenerationConfigurationByStreamARN(ctx, conn, streamARN)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Kinesis Video Image Generation Configuration (%s)", streamARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *imageGenerationConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new imageGenerationConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	streamARN := new.StreamARN.ValueString()
+	var configuration awstypes.ImageGenerationConfiguration
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &configuration)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := kinesisvideo.UpdateImageGenerationConfigurationInput{
+		ImageGenerationConfiguration: &configuration,
+		StreamARN:                    aws.String(streamARN),
+	}
+
+	_, err := conn.UpdateImageGenerationConfiguration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Kinesis Video Image Generation Configuration (%s)", streamARN), err.Error())
+
+		return
+	}
+
+	output, err := findImageGenerationConfigurationByStreamARN(ctx, conn, streamARN)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Kinesis Video Image Generation Configuration (%s)", streamARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.FormatConfig, &new.FormatConfig)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *imageGenerationConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data imageGenerationConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	// A null configuration removes image generation from the stream.
+	streamARN := data.StreamARN.ValueString()
+	input := kinesisvideo.UpdateImageGenerationConfigurationInput{
+		StreamARN: aws.String(streamARN),
+	}
+	_, err := conn.UpdateImageGenerationConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Kinesis Video Image Generation Configuration (%s)", streamARN), err.Error())
+
+		return
+	}
+}
+
+func (r *imageGenerationConfigurationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrStreamARN), request, response)
+}
+
+func findImageGenerationConfigurationByStreamARN(ctx context.Context, conn *kinesisvideo.Client, arn string) (*awstypes.ImageGenerationConfiguration, error) {
+	input := kinesisvideo.DescribeImageGenerationConfigurationInput{
+		StreamARN: aws.String(arn),
+	}
+
+	return findImageGenerationConfiguration(ctx, conn, &input)
+}
+
+func findImageGenerationConfiguration(ctx context.Context, conn *kinesisvideo.Client, input *kinesisvideo.DescribeImageGenerationConfigurationInput) (*awstypes.ImageGenerationConfiguration, error) {
+	output, err := conn.DescribeImageGenerationConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ImageGenerationConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ImageGenerationConfiguration, nil
+}
+
+type imageGenerationConfigurationResourceModel struct {
+	framework.WithRegionModel
+	DestinationConfig fwtypes.ListNestedObjectValueOf[imageGenerationDestinationConfigModel] `tfsdk:"destination_config"`
+	Format            fwtypes.StringEnum[awstypes.Format]                                    `tfsdk:"format"`
+	FormatConfig      fwtypes.MapOfString                                                    `tfsdk:"format_config"`
+	HeightPixels      types.Int32                                                            `tfsdk:"height_pixels"`
+	ImageSelectorType fwtypes.StringEnum[awstypes.ImageSelectorType]                         `tfsdk:"image_selector_type"`
+	SamplingInterval  types.Int32                                                            `tfsdk:"sampling_interval"`
+	Status            fwtypes.StringEnum[awstypes.ConfigurationStatus]                       `tfsdk:"status"`
+	StreamARN         fwtypes.ARN                                                            `tfsdk:"stream_arn"`
+	WidthPixels       types.Int32                                                            `tfsdk:"width_pixels"`
+}
+
+type imageGenerationDestinationConfigModel struct {
+	DestinationRegion types.String `tfsdk:"destination_region"`
+	URI               types.String `tfsdk:"uri"`
+}
@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfkinesisvideo "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisvideo"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKinesisVideoImageGenerationConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var configuration awstypes.ImageGenerationConfiguration
+	resourceName := "aws_kinesisvideo_image_generation_configuration.test"
+	streamResourceName := "aws_kinesis_video_stream.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageGenerationConfigurationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageGenerationConfigurationConfig_basic(rName, "JPEG", 3000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckImageGenerationConfigurationExists(ctx, t, resourceName, &configuration),
+					resource.TestCheckResourceAttr(resourceName, "destination_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_config.0.destination_region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, "JPEG"),
+					resource.TestCheckResourceAttr(resourceName, "image_selector_type", "SERVER_TIMESTAMP"),
+					resource.TestCheckResourceAttr(resourceName, "sampling_interval", "3000"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ENABLED"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrStreamARN, streamResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrStreamARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrStreamARN,
+			},
+			{
+				Config: testAccImageGenerationConfigurationConfig_basic(rName, "PNG", 5000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckImageGenerationConfigurationExists(ctx, t, resourceName, &configuration),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, "PNG"),
+					resource.TestCheckResourceAttr(resourceName, "sampling_interval", "5000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKinesisVideoImageGenerationConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var configuration awstypes.ImageGenerationConfiguration
+	resourceName := "aws_kinesisvideo_image_generation_configuration.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageGenerationConfigurationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageGenerationConfigurationConfig_basic(rName, "JPEG", 3000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageGenerationConfigurationExists(ctx, t, resourceName, &configuration),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfkinesisvideo.ResourceImageGenerationConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckImageGenerationConfigurationExists(ctx context.Context, t *testing.T, n string, v *awstypes.ImageGenerationConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).KinesisVideoClient(ctx)
+
+		output, err := tfkinesisvideo.FindImageGenerationConfigurationByStreamARN(ctx, conn, rs.Primary.Attributes[names.AttrStreamARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckImageGenerationConfigurationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).KinesisVideoClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kinesisvideo_image_generation_configuration" {
+				continue
+			}
+
+			_, err := tfkinesisvideo.FindImageGenerationConfigurationByStreamARN(ctx, conn, rs.Primary.Attributes[names.AttrStreamARN])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Kinesis Video Image Generation Configuration %s still exists", rs.Primary.Attributes[names.AttrStreamARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccImageGenerationConfigurationConfig_basic(rName, format string, samplingInterval int) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_kinesis_video_stream" "test" {
+  name                    = %[1]q
+  data_retention_in_hours = 1
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_kinesisvideo_image_generation_configuration" "test" {
+  stream_arn          = aws_kinesis_video_stream.test.arn
+  status              = "ENABLED"
+  format              = %[2]q
+  image_selector_type = "SERVER_TIMESTAMP"
+  sampling_interval   = %[3]d
+
+  destination_config {
+    destination_region = data.aws_region.current.region
+    uri                = "s3://${aws_s3_bucket.test.bucket}/images"
+  }
+}
+`, rName, format, samplingInterval)
+}
@@ -1,7 +1,7 @@
 // Copyright IBM Corp. 2014, 2026
 // SPDX-License-Identifier: MPL-2.0
 
-// Code generated by "internal/generate/listpages/main.go -ListOps=ListTagsForStream"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=ListTagsForResource,ListTagsForStream"; DO NOT EDIT.
 
 package kinesisvideo
 
@@ -13,6 +13,22 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
 )
 
+func listTagsForResourcePages(ctx context.Context, conn *kinesisvideo.Client, input *kinesisvideo.ListTagsForResourceInput, fn func(*kinesisvideo.ListTagsForResourceOutput, bool) bool, optFns ...func(*kinesisvideo.Options)) error {
+	for {
+		output, err := conn.ListTagsForResource(ctx, input, optFns...)
+		if err != nil {
+			return smarterr.NewError(err)
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
 func listTagsForStreamPages(ctx context.Context, conn *kinesisvideo.Client, input *kinesisvideo.ListTagsForStreamInput, fn func(*kinesisvideo.ListTagsForStreamOutput, bool) bool, optFns ...func(*kinesisvideo.Options)) error {
 	for {
 		output, err := conn.ListTagsForStream(ctx, input, optFns...)
@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_kinesisvideo_media_storage_configuration", name="Media Storage Configuration")
+func newMediaStorageConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &mediaStorageConfigurationResource{}
+
+	return r, nil
+}
+
+type mediaStorageConfigurationResource struct {
+	framework.ResourceWithModel[mediaStorageConfigurationResourceModel]
+}
+
+func (r *mediaStorageConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"channel_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MediaStorageConfigurationStatus](),
+				Required:   true,
+			},
+			names.AttrStreamARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (r *mediaStorageConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data mediaStorageConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	channelARN := data.ChannelARN.ValueString()
+	input := kinesisvideo.UpdateMediaStorageConfigurationInput{
+		ChannelARN: aws.String(channelARN),
+		MediaStorageConfiguration: &awstypes.MediaStorageConfiguration{
+			Status:    data.Status.ValueEnum(),
+			StreamARN: fwflex.StringFromFramework(ctx, data.StreamARN),
+		},
+	}
+
+	_, err := conn.UpdateMediaStorageConfiguration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Kinesis Video Media Storage Configuration (%s)", channelARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *mediaStorageConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data mediaStorageConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	channelARN := data.ChannelARN.ValueString()
+	output, err := findMediaStorageConfigurationByChannelARN(ctx, conn, channelARN)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Kinesis Video Media Storage Configuration (%s)", channelARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *mediaStorageConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new mediaStorageConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	channelARN := new.ChannelARN.ValueString()
+	input := kinesisvideo.UpdateMediaStorageConfigurationInput{
+		ChannelARN: aws.String(channelARN),
+		MediaStorageConfiguration: &awstypes.MediaStorageConfiguration{
+			Status:    new.Status.ValueEnum(),
+			StreamARN: fwflex.StringFromFramework(ctx, new.StreamARN),
+		},
+	}
+
+	_, err := conn.UpdateMediaStorageConfiguration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Kinesis Video Media Storage Configuration (%s)", channelARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *mediaStorageConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data mediaStorageConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	// Media storage is disabled rather than deleted.
+	channelARN := data.ChannelARN.ValueString()
+	input := kinesisvideo.UpdateMediaStorageConfigurationInput{
+		ChannelARN: aws.String(channelARN),
+		MediaStorageConfiguration: &awstypes.MediaStorageConfiguration{
+			Status: awstypes.MediaStorageConfigurationStatusDisabled,
+		},
+	}
+
+	_, err := conn.UpdateMediaStorageConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Kinesis Video Media Storage Configuration (%s)", channelARN), err.Error())
+
+		return
+	}
+}
+
+func (r *mediaStorageConfigurationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("channel_arn"), request, response)
+}
+
+func findMediaStorageConfigurationByChannelARN(ctx context.Context, conn *kinesisvideo.Client, arn string) (*awstypes.MediaStorageConfiguration, error) {
+	input := kinesisvideo.DescribeMediaStorageConfigurationInput{
+		ChannelARN: aws.String(arn),
+	}
+
+	return findMediaStorageConfiguration(ctx, conn, &input)
+}
+
+func findMediaStorageConfiguration(ctx context.Context, conn *kinesisvideo.Client, input *kinesisvideo.DescribeMediaStorageConfigurationInput) (*awstypes.MediaStorageConfiguration, error) {
+	output, err := conn.DescribeMediaStorageConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.MediaStorageConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.MediaStorageConfiguration, nil
+}
+
+type mediaStorageConfigurationResourceModel struct {
+	framework.WithRegionModel
+	ChannelARN fwtypes.ARN                                                  `tfsdk:"channel_arn"`
+	Status     fwtypes.StringEnum[awstypes.MediaStorageConfigurationStatus] `tfsdk:"status"`
+	StreamARN  fwtypes.ARN                                                  `tfsdk:"stream_arn"`
+}
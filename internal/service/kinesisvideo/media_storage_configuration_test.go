@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfkinesisvideo "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisvideo"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKinesisVideoMediaStorageConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var configuration awstypes.MediaStorageConfiguration
+	resourceName := "aws_kinesisvideo_media_storage_configuration.test"
+	channelResourceName := "aws_kinesisvideo_signaling_channel.test"
+	streamResourceName := "aws_kinesis_video_stream.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMediaStorageConfigurationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaStorageConfigurationConfig_basic(rName, "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMediaStorageConfigurationExists(ctx, t, resourceName, &configuration),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_arn", channelResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ENABLED"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrStreamARN, streamResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "channel_arn"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "channel_arn",
+			},
+			{
+				Config: testAccMediaStorageConfigurationConfig_basic(rName, "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMediaStorageConfigurationExists(ctx, t, resourceName, &configuration),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMediaStorageConfigurationExists(ctx context.Context, t *testing.T, n string, v *awstypes.MediaStorageConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).KinesisVideoClient(ctx)
+
+		output, err := tfkinesisvideo.FindMediaStorageConfigurationByChannelARN(ctx, conn, rs.Primary.Attributes["channel_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckMediaStorageConfigurationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).KinesisVideoClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kinesisvideo_media_storage_configuration" {
+				continue
+			}
+
+			output, err := tfkinesisvideo.FindMediaStorageConfigurationByChannelARN(ctx, conn, rs.Primary.Attributes["channel_arn"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if output.Status == awstypes.MediaStorageConfigurationStatusDisabled {
+				continue
+			}
+
+			return fmt.Errorf("Kinesis Video Media Storage Configuration %s still enabled", rs.Primary.Attributes["channel_arn"])
+		}
+
+		return nil
+	}
+}
+
+func testAccMediaStorageConfigurationConfig_basic(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name                    = %[1]q
+  data_retention_in_hours = 1
+}
+
+resource "aws_kinesisvideo_signaling_channel" "test" {
+  name = %[1]q
+}
+
+resource "aws_kinesisvideo_media_storage_configuration" "test" {
+  channel_arn = aws_kinesisvideo_signaling_channel.test.arn
+  stream_arn  = aws_kinesis_video_stream.test.arn
+  status      = %[2]q
+}
+`, rName, status)
+}
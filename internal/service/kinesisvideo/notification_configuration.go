@@ -0,0 +1,241 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_kinesisvideo_notification_configuration", name="Notification Configuration")
+func newNotificationConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &notificationConfigurationResource{}
+
+	return r, nil
+}
+
+type notificationConfigurationResource struct {
+	framework.ResourceWithModel[notificationConfigurationResourceModel]
+}
+
+func (r *notificationConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConfigurationStatus](),
+				Required:   true,
+			},
+			names.AttrStreamARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"destination_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[notificationDestinationConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrURI: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *notificationConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data notificationConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	streamARN := data.StreamARN.ValueString()
+	var configuration awstypes.NotificationConfiguration
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &configuration)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := kinesisvideo.UpdateNotificationConfigurationInput{
+		NotificationConfiguration: &configuration,
+		StreamARN:                 aws.String(streamARN),
+	}
+
+	_, err := conn.UpdateNotificationConfiguration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Kinesis Video Notification Configuration (%s)", streamARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *notificationConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data notificationConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	streamARN := data.StreamARN.ValueString()
+	output, err := findNotificationConfigurationByStreamARN(ctx, conn, streamARN)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Kinesis Video Notification Configuration (%s)", streamARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *notificationConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new notificationConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	streamARN := new.StreamARN.ValueString()
+	var configuration awstypes.NotificationConfiguration
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &configuration)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := kinesisvideo.UpdateNotificationConfigurationInput{
+		NotificationConfiguration: &configuration,
+		StreamARN:                 aws.String(streamARN),
+	}
+
+	_, err := conn.UpdateNotificationConfiguration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Kinesis Video Notification Configuration (%s)", streamARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *notificationConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data notificationConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	// A null configuration removes notifications from the stream.
+	streamARN := data.StreamARN.ValueString()
+	input := kinesisvideo.UpdateNotificationConfigurationInput{
+		StreamARN: aws.String(streamARN),
+	}
+	_, err := conn.UpdateNotificationConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Kinesis Video Notification Configuration (%s)", streamARN), err.Error())
+
+		return
+	}
+}
+
+func (r *notificationConfigurationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrStreamARN), request, response)
+}
+
+func findNotificationConfigurationByStreamARN(ctx context.Context, conn *kinesisvideo.Client, arn string) (*awstypes.NotificationConfiguration, error) {
+	input := kinesisvideo.DescribeNotificationConfigurationInput{
+		StreamARN: aws.String(arn),
+	}
+
+	return findNotificationConfiguration(ctx, conn, &input)
+}
+
+func findNotificationConfiguration(ctx context.Context, conn *kinesisvideo.Client, input *kinesisvideo.DescribeNotificationConfigurationInput) (*awstypes.NotificationConfiguration, error) {
+	output, err := conn.DescribeNotificationConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.NotificationConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.NotificationConfiguration, nil
+}
+
+type notificationConfigurationResourceModel struct {
+	framework.WithRegionModel
+	DestinationConfig fwtypes.ListNestedObjectValueOf[notificationDestinationConfigModel] `tfsdk:"destination_config"`
+	Status            fwtypes.StringEnum[awstypes.ConfigurationStatus]                    `tfsdk:"status"`
+	StreamARN         fwtypes.ARN                                                         `tfsdk:"stream_arn"`
+}
+
+type notificationDestinationConfigModel struct {
+	URI types.String `tfsdk:"uri"`
+}
@@ -21,11 +21,53 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
-	return []*inttypes.ServicePackageFrameworkDataSource{}
+	return []*inttypes.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newSignalingChannelEndpointDataSource,
+			TypeName: "aws_kinesisvideo_signaling_channel_endpoint",
+			Name:     "Signaling Channel Endpoint",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
-	return []*inttypes.ServicePackageFrameworkResource{}
+	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newEdgeConfigurationResource,
+			TypeName: "aws_kinesisvideo_edge_configuration",
+			Name:     "Edge Configuration",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newImageGenerationConfigurationResource,
+			TypeName: "aws_kinesisvideo_image_generation_configuration",
+			Name:     "Image Generation Configuration",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newMediaStorageConfigurationResource,
+			TypeName: "aws_kinesisvideo_media_storage_configuration",
+			Name:     "Media Storage Configuration",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newNotificationConfigurationResource,
+			TypeName: "aws_kinesisvideo_notification_configuration",
+			Name:     "Notification Configuration",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newSignalingChannelResource,
+			TypeName: "aws_kinesisvideo_signaling_channel",
+			Name:     "Signaling Channel",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+				ResourceType:        "SignalingChannel",
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.ServicePackageSDKDataSource {
@@ -40,6 +82,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Stream",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				ResourceType:        "Stream",
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -0,0 +1,414 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_kinesisvideo_signaling_channel", name="Signaling Channel")
+// @Tags(identifierAttribute="arn", resourceType="SignalingChannel")
+func newSignalingChannelResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &signalingChannelResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type signalingChannelResource struct {
+	framework.ResourceWithModel[signalingChannelResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *signalingChannelResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"channel_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ChannelType](),
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(string(awstypes.ChannelTypeSingleMaster)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"message_ttl_seconds": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.Between(5, 120),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *signalingChannelResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data signalingChannelResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	name := data.Name.ValueString()
+	input := kinesisvideo.CreateSignalingChannelInput{
+		ChannelName: aws.String(name),
+		ChannelType: data.ChannelType.ValueEnum(),
+		Tags:        signalingChannelTags(keyValueTags(ctx, getTagsIn(ctx))),
+	}
+
+	if !data.MessageTTLSeconds.IsUnknown() {
+		input.SingleMasterConfiguration = &awstypes.SingleMasterConfiguration{
+			MessageTtlSeconds: fwflex.Int32FromFramework(ctx, data.MessageTTLSeconds),
+		}
+	}
+
+	output, err := conn.CreateSignalingChannel(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Kinesis Video Signaling Channel (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.ChannelARN)
+
+	channel, err := waitSignalingChannelCreated(ctx, conn, data.ARN.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), data.ARN) // Set 'arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Kinesis Video Signaling Channel (%s) create", data.ARN.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenSignalingChannel(ctx, channel, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *signalingChannelResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data signalingChannelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	output, err := findSignalingChannelByARN(ctx, conn, data.ARN.ValueString())
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Kinesis Video Signaling Channel (%s)", data.ARN.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenSignalingChannel(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *signalingChannelResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new signalingChannelResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	arn := new.ARN.ValueString()
+
+	if !new.MessageTTLSeconds.Equal(old.MessageTTLSeconds) {
+		input := kinesisvideo.UpdateSignalingChannelInput{
+			ChannelARN:     aws.String(arn),
+			CurrentVersion: old.Version.ValueStringPointer(),
+			SingleMasterConfiguration: &awstypes.SingleMasterConfiguration{
+				MessageTtlSeconds: fwflex.Int32FromFramework(ctx, new.MessageTTLSeconds),
+			},
+		}
+
+		_, err := conn.UpdateSignalingChannel(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Kinesis Video Signaling Channel (%s)", arn), err.Error())
+
+			return
+		}
+
+		if _, err := waitSignalingChannelUpdated(ctx, conn, arn, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Kinesis Video Signaling Channel (%s) update", arn), err.Error())
+
+			return
+		}
+	}
+
+	// The channel version changes on every update.
+	output, err := findSignalingChannelByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Kinesis Video Signaling Channel (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenSignalingChannel(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *signalingChannelResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data signalingChannelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KinesisVideoClient(ctx)
+
+	arn := data.ARN.ValueString()
+	input := kinesisvideo.DeleteSignalingChannelInput{
+		ChannelARN: aws.String(arn),
+	}
+	_, err := conn.DeleteSignalingChannel(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Kinesis Video Signaling Channel (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitSignalingChannelDeleted(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Kinesis Video Signaling Channel (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *signalingChannelResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+func flattenSignalingChannel(ctx context.Context, apiObject *awstypes.ChannelInfo, data *signalingChannelResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ARN = fwflex.StringToFramework(ctx, apiObject.ChannelARN)
+	data.ChannelType = fwtypes.StringEnumValue(apiObject.ChannelType)
+	data.CreationTime = timetypes.NewRFC3339TimePointerValue(apiObject.CreationTime)
+	if v := apiObject.SingleMasterConfiguration; v != nil {
+		data.MessageTTLSeconds = fwflex.Int32ToFramework(ctx, v.MessageTtlSeconds)
+	} else {
+		data.MessageTTLSeconds = types.Int32Null()
+	}
+	data.Name = fwflex.StringToFramework(ctx, apiObject.ChannelName)
+	data.Version = fwflex.StringToFramework(ctx, apiObject.Version)
+
+	return diags
+}
+
+func findSignalingChannelByARN(ctx context.Context, conn *kinesisvideo.Client, arn string) (*awstypes.ChannelInfo, error) {
+	input := kinesisvideo.DescribeSignalingChannelInput{
+		ChannelARN: aws.String(arn),
+	}
+
+	return findSignalingChannel(ctx, conn, &input)
+}
+
+func findSignalingChannel(ctx context.Context, conn *kinesisvideo.Client, input *kinesisvideo.DescribeSignalingChannelInput) (*awstypes.ChannelInfo, error) {
+	output, err := conn.DescribeSignalingChannel(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ChannelInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ChannelInfo, nil
+}
+
+func statusSignalingChannel(conn *kinesisvideo.Client, arn string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findSignalingChannelByARN(ctx, conn, arn)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ChannelStatus), nil
+	}
+}
+
+func waitSignalingChannelCreated(ctx context.Context, conn *kinesisvideo.Client, arn string, timeout time.Duration) (*awstypes.ChannelInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusCreating),
+		Target:     enum.Slice(awstypes.StatusActive),
+		Refresh:    statusSignalingChannel(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ChannelInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSignalingChannelUpdated(ctx context.Context, conn *kinesisvideo.Client, arn string, timeout time.Duration) (*awstypes.ChannelInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusUpdating),
+		Target:     enum.Slice(awstypes.StatusActive),
+		Refresh:    statusSignalingChannel(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ChannelInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSignalingChannelDeleted(ctx context.Context, conn *kinesisvideo.Client, arn string, timeout time.Duration) (*awstypes.ChannelInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusDeleting),
+		Target:     []string{},
+		Refresh:    statusSignalingChannel(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ChannelInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type signalingChannelResourceModel struct {
+	framework.WithRegionModel
+	ARN               types.String                             `tfsdk:"arn"`
+	ChannelType       fwtypes.StringEnum[awstypes.ChannelType] `tfsdk:"channel_type"`
+	CreationTime      timetypes.RFC3339                        `tfsdk:"creation_time"`
+	MessageTTLSeconds types.Int32                              `tfsdk:"message_ttl_seconds"`
+	Name              types.String                             `tfsdk:"name"`
+	Tags              tftags.Map                               `tfsdk:"tags"`
+	TagsAll           tftags.Map                               `tfsdk:"tags_all"`
+	Timeouts          timeouts.Value                           `tfsdk:"timeouts"`
+	Version           types.String                             `tfsdk:"version"`
+}
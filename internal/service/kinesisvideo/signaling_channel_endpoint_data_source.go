@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_kinesisvideo_signaling_channel_endpoint", name="Signaling Channel Endpoint")
+func newSignalingChannelEndpointDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &signalingChannelEndpointDataSource{}, nil
+}
+
+type signalingChannelEndpointDataSource struct {
+	framework.DataSourceWithModel[signalingChannelEndpointDataSourceModel]
+}
+
+func (d *signalingChannelEndpointDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"channel_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"protocols": schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringEnumType[awstypes.ChannelProtocol](),
+				Optional:   true,
+			},
+			"resource_endpoint_list": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[resourceEndpointListItemModel](ctx),
+				Computed:   true,
+			},
+			names.AttrRole: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ChannelRole](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *signalingChannelEndpointDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data signalingChannelEndpointDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().KinesisVideoClient(ctx)
+
+	channelARN := data.ChannelARN.ValueString()
+	var configuration awstypes.SingleMasterChannelEndpointConfiguration
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &configuration)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := kinesisvideo.GetSignalingChannelEndpointInput{
+		ChannelARN:                               aws.String(channelARN),
+		SingleMasterChannelEndpointConfiguration: &configuration,
+	}
+
+	output, err := findSignalingChannelEndpoints(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Kinesis Video Signaling Channel (%s) endpoints", channelARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.ResourceEndpointList)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findSignalingChannelEndpoints(ctx context.Context, conn *kinesisvideo.Client, input *kinesisvideo.GetSignalingChannelEndpointInput) ([]awstypes.ResourceEndpointListItem, error) {
+	output, err := conn.GetSignalingChannelEndpoint(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ResourceEndpointList, nil
+}
+
+type signalingChannelEndpointDataSourceModel struct {
+	framework.WithRegionModel
+	ChannelARN           fwtypes.ARN                                                    `tfsdk:"channel_arn"`
+	Protocols            fwtypes.SetOfStringEnum[awstypes.ChannelProtocol]              `tfsdk:"protocols"`
+	ResourceEndpointList fwtypes.ListNestedObjectValueOf[resourceEndpointListItemModel] `tfsdk:"resource_endpoint_list"`
+	Role                 fwtypes.StringEnum[awstypes.ChannelRole]                       `tfsdk:"role"`
+}
+
+type resourceEndpointListItemModel struct {
+	Protocol         fwtypes.StringEnum[awstypes.ChannelProtocol] `tfsdk:"protocol"`
+	ResourceEndpoint types.String                                 `tfsdk:"resource_endpoint"`
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKinesisVideoSignalingChannelEndpointDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_kinesisvideo_signaling_channel_endpoint.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalingChannelEndpointDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_endpoint_list.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_endpoint_list.*", map[string]string{
+						names.AttrProtocol: "HTTPS",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_endpoint_list.*", map[string]string{
+						names.AttrProtocol: "WSS",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccSignalingChannelEndpointDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesisvideo_signaling_channel" "test" {
+  name = %[1]q
+}
+
+data "aws_kinesisvideo_signaling_channel_endpoint" "test" {
+  channel_arn = aws_kinesisvideo_signaling_channel.test.arn
+  protocols   = ["HTTPS", "WSS"]
+  role        = "MASTER"
+}
+`, rName)
+}
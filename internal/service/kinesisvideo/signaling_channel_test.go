@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfkinesisvideo "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisvideo"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKinesisVideoSignalingChannel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var channel awstypes.ChannelInfo
+	resourceName := "aws_kinesisvideo_signaling_channel.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalingChannelDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalingChannelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalingChannelExists(ctx, t, resourceName, &channel),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "kinesisvideo", regexache.MustCompile(`channel/`+rName+`/\d+$`)),
+					resource.TestCheckResourceAttr(resourceName, "channel_type", "SINGLE_MASTER"),
+					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreationTime),
+					resource.TestCheckResourceAttr(resourceName, "message_ttl_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrVersion),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+		},
+	})
+}
+
+func TestAccKinesisVideoSignalingChannel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var channel awstypes.ChannelInfo
+	resourceName := "aws_kinesisvideo_signaling_channel.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalingChannelDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalingChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalingChannelExists(ctx, t, resourceName, &channel),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfkinesisvideo.ResourceSignalingChannel, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKinesisVideoSignalingChannel_messageTTLSeconds(t *testing.T) {
+	ctx := acctest.Context(t)
+	var channel awstypes.ChannelInfo
+	resourceName := "aws_kinesisvideo_signaling_channel.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalingChannelDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalingChannelConfig_messageTTLSeconds(rName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalingChannelExists(ctx, t, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "message_ttl_seconds", "30"),
+				),
+			},
+			{
+				Config: testAccSignalingChannelConfig_messageTTLSeconds(rName, 90),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalingChannelExists(ctx, t, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "message_ttl_seconds", "90"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKinesisVideoSignalingChannel_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var channel awstypes.ChannelInfo
+	resourceName := "aws_kinesisvideo_signaling_channel.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalingChannelDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalingChannelConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalingChannelExists(ctx, t, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccSignalingChannelConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalingChannelExists(ctx, t, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccSignalingChannelConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalingChannelExists(ctx, t, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSignalingChannelExists(ctx context.Context, t *testing.T, n string, v *awstypes.ChannelInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).KinesisVideoClient(ctx)
+
+		output, err := tfkinesisvideo.FindSignalingChannelByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSignalingChannelDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).KinesisVideoClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kinesisvideo_signaling_channel" {
+				continue
+			}
+
+			_, err := tfkinesisvideo.FindSignalingChannelByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Kinesis Video Signaling Channel %s still exists", rs.Primary.Attributes[names.AttrARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccSignalingChannelConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesisvideo_signaling_channel" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccSignalingChannelConfig_messageTTLSeconds(rName string, ttl int) string {
+	return fmt.Sprintf(`
+resource "aws_kinesisvideo_signaling_channel" "test" {
+  name                = %[1]q
+  message_ttl_seconds = %[2]d
+}
+`, rName, ttl)
+}
+
+func testAccSignalingChannelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesisvideo_signaling_channel" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccSignalingChannelConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesisvideo_signaling_channel" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
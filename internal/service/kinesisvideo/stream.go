@@ -26,7 +26,7 @@ import (
 )
 
 // @SDKResource("aws_kinesis_video_stream", name="Stream")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", resourceType="Stream")
 func resourceStream() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStreamCreate,
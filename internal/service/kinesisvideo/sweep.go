@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_kinesisvideo_signaling_channel", sweepSignalingChannels)
+}
+
+func sweepSignalingChannels(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.KinesisVideoClient(ctx)
+	var input kinesisvideo.ListSignalingChannelsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := kinesisvideo.NewListSignalingChannelsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ChannelInfoList {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newSignalingChannelResource, client,
+				framework.NewAttribute(names.AttrARN, aws.ToString(v.ChannelARN)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
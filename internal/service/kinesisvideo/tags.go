@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !generate
+
+package kinesisvideo
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Streams and signaling channels are tagged via different APIs.
+// Custom Kinesis Video tag service functions using the same format as generated code.
+
+const (
+	resourceTypeSignalingChannel = "SignalingChannel"
+	resourceTypeStream           = "Stream"
+)
+
+// streamListTags lists Kinesis Video Stream tags.
+// The identifier is the Stream ARN.
+func streamListTags(ctx context.Context, conn *kinesisvideo.Client, identifier string, optFns ...func(*kinesisvideo.Options)) (tftags.KeyValueTags, error) {
+	input := kinesisvideo.ListTagsForStreamInput{
+		StreamARN: aws.String(identifier),
+	}
+
+	output := make(map[string]string)
+
+	err := listTagsForStreamPages(ctx, conn, &input, func(page *kinesisvideo.ListTagsForStreamOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		maps.Copy(output, page.Tags)
+
+		return !lastPage
+	}, optFns...)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	return keyValueTags(ctx, output), nil
+}
+
+// streamUpdateTags updates Kinesis Video Stream tags.
+// The identifier is the Stream ARN.
+func streamUpdateTags(ctx context.Context, conn *kinesisvideo.Client, identifier string, oldTagsMap, newTagsMap any, optFns ...func(*kinesisvideo.Options)) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	ctx = tflog.SetField(ctx, logging.KeyResourceId, identifier)
+
+	removedTags := oldTags.Removed(newTags)
+	removedTags = removedTags.IgnoreSystem(names.KinesisVideo)
+	if len(removedTags) > 0 {
+		input := kinesisvideo.UntagStreamInput{
+			StreamARN:  aws.String(identifier),
+			TagKeyList: removedTags.Keys(),
+		}
+
+		_, err := conn.UntagStream(ctx, &input, optFns...)
+
+		if err != nil {
+			return smarterr.NewError(err)
+		}
+	}
+
+	updatedTags := oldTags.Updated(newTags)
+	updatedTags = updatedTags.IgnoreSystem(names.KinesisVideo)
+	if len(updatedTags) > 0 {
+		input := kinesisvideo.TagStreamInput{
+			StreamARN: aws.String(identifier),
+			Tags:      svcTags(updatedTags),
+		}
+
+		_, err := conn.TagStream(ctx, &input, optFns...)
+
+		if err != nil {
+			return smarterr.NewError(err)
+		}
+	}
+
+	return nil
+}
+
+// signalingChannelListTags lists Kinesis Video Signaling Channel tags.
+// The identifier is the Signaling Channel ARN.
+func signalingChannelListTags(ctx context.Context, conn *kinesisvideo.Client, identifier string, optFns ...func(*kinesisvideo.Options)) (tftags.KeyValueTags, error) {
+	input := kinesisvideo.ListTagsForResourceInput{
+		ResourceARN: aws.String(identifier),
+	}
+
+	output := make(map[string]string)
+
+	err := listTagsForResourcePages(ctx, conn, &input, func(page *kinesisvideo.ListTagsForResourceOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		maps.Copy(output, page.Tags)
+
+		return !lastPage
+	}, optFns...)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	return keyValueTags(ctx, output), nil
+}
+
+// signalingChannelUpdateTags updates Kinesis Video Signaling Channel tags.
+// The identifier is the Signaling Channel ARN.
+func signalingChannelUpdateTags(ctx context.Context, conn *kinesisvideo.Client, identifier string, oldTagsMap, newTagsMap any, optFns ...func(*kinesisvideo.Options)) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	ctx = tflog.SetField(ctx, logging.KeyResourceId, identifier)
+
+	removedTags := oldTags.Removed(newTags)
+	removedTags = removedTags.IgnoreSystem(names.KinesisVideo)
+	if len(removedTags) > 0 {
+		input := kinesisvideo.UntagResourceInput{
+			ResourceARN: aws.String(identifier),
+			TagKeyList:  removedTags.Keys(),
+		}
+
+		_, err := conn.UntagResource(ctx, &input, optFns...)
+
+		if err != nil {
+			return smarterr.NewError(err)
+		}
+	}
+
+	updatedTags := oldTags.Updated(newTags)
+	updatedTags = updatedTags.IgnoreSystem(names.KinesisVideo)
+	if len(updatedTags) > 0 {
+		input := kinesisvideo.TagResourceInput{
+			ResourceARN: aws.String(identifier),
+			Tags:        signalingChannelTags(updatedTags),
+		}
+
+		_, err := conn.TagResource(ctx, &input, optFns...)
+
+		if err != nil {
+			return smarterr.NewError(err)
+		}
+	}
+
+	return nil
+}
+
+// signalingChannelTags returns Kinesis Video Signaling Channel tags.
+// CreateSignalingChannel and TagResource take a slice of tags whereas ListTagsForResource returns a map.
+func signalingChannelTags(tags tftags.KeyValueTags) []awstypes.Tag {
+	result := make([]awstypes.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		result = append(result, awstypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return result
+}
+
+// ListTags lists kinesisvideo service tags and set them in Context.
+// It is called from outside this package.
+func (p *servicePackage) ListTags(ctx context.Context, meta any, identifier, resourceType string) error {
+	var (
+		tags tftags.KeyValueTags
+		err  error
+	)
+	switch resourceType {
+	case resourceTypeSignalingChannel:
+		tags, err = signalingChannelListTags(ctx, meta.(*conns.AWSClient).KinesisVideoClient(ctx), identifier)
+
+	case resourceTypeStream:
+		tags, err = streamListTags(ctx, meta.(*conns.AWSClient).KinesisVideoClient(ctx), identifier)
+
+	default:
+		return nil
+	}
+
+	if err != nil {
+		return smarterr.NewError(err)
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = option.Some(tags)
+	}
+
+	return nil
+}
+
+// UpdateTags updates kinesisvideo service tags.
+// It is called from outside this package.
+func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier, resourceType string, oldTags, newTags any) error {
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	switch resourceType {
+	case resourceTypeSignalingChannel:
+		return signalingChannelUpdateTags(ctx, conn, identifier, oldTags, newTags)
+
+	case resourceTypeStream:
+		return streamUpdateTags(ctx, conn, identifier, oldTags, newTags)
+	}
+
+	return fmt.Errorf("unsupported resource type: %s", resourceType)
+}
@@ -6,62 +6,11 @@ package kinesisvideo
 
 import (
 	"context"
-	"maps"
 
-	"github.com/YakDriver/smarterr"
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
-	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// listTags lists kinesisvideo service tags.
-// The identifier is typically the Amazon Resource Name (ARN), although
-// it may also be a different identifier depending on the service.
-func listTags(ctx context.Context, conn *kinesisvideo.Client, identifier string, optFns ...func(*kinesisvideo.Options)) (tftags.KeyValueTags, error) {
-	input := kinesisvideo.ListTagsForStreamInput{
-		StreamARN: aws.String(identifier),
-	}
-
-	output := make(map[string]string)
-
-	err := listTagsForStreamPages(ctx, conn, &input, func(page *kinesisvideo.ListTagsForStreamOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
-		}
-
-		maps.Copy(output, page.Tags)
-
-		return !lastPage
-	}, optFns...)
-
-	if err != nil {
-		return tftags.New(ctx, nil), err
-	}
-
-	return keyValueTags(ctx, output), nil
-}
-
-// ListTags lists kinesisvideo service tags and set them in Context.
-// It is called from outside this package.
-func (p *servicePackage) ListTags(ctx context.Context, meta any, identifier string) error {
-	tags, err := listTags(ctx, meta.(*conns.AWSClient).KinesisVideoClient(ctx), identifier)
-
-	if err != nil {
-		return smarterr.NewError(err)
-	}
-
-	if inContext, ok := tftags.FromContext(ctx); ok {
-		inContext.TagsOut = option.Some(tags)
-	}
-
-	return nil
-}
-
 // map[string]string handling
 
 // svcTags returns kinesisvideo service tags.
@@ -92,51 +41,3 @@ func setTagsOut(ctx context.Context, tags map[string]string) {
 		inContext.TagsOut = option.Some(keyValueTags(ctx, tags))
 	}
 }
-
-// updateTags updates kinesisvideo service tags.
-// The identifier is typically the Amazon Resource Name (ARN), although
-// it may also be a different identifier depending on the service.
-func updateTags(ctx context.Context, conn *kinesisvideo.Client, identifier string, oldTagsMap, newTagsMap any, optFns ...func(*kinesisvideo.Options)) error {
-	oldTags := tftags.New(ctx, oldTagsMap)
-	newTags := tftags.New(ctx, newTagsMap)
-
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, identifier)
-
-	removedTags := oldTags.Removed(newTags)
-	removedTags = removedTags.IgnoreSystem(names.KinesisVideo)
-	if len(removedTags) > 0 {
-		input := kinesisvideo.UntagStreamInput{
-			StreamARN:  aws.String(identifier),
-			TagKeyList: removedTags.Keys(),
-		}
-
-		_, err := conn.UntagStream(ctx, &input, optFns...)
-
-		if err != nil {
-			return smarterr.NewError(err)
-		}
-	}
-
-	updatedTags := oldTags.Updated(newTags)
-	updatedTags = updatedTags.IgnoreSystem(names.KinesisVideo)
-	if len(updatedTags) > 0 {
-		input := kinesisvideo.TagStreamInput{
-			StreamARN: aws.String(identifier),
-			Tags:      svcTags(updatedTags),
-		}
-
-		_, err := conn.TagStream(ctx, &input, optFns...)
-
-		if err != nil {
-			return smarterr.NewError(err)
-		}
-	}
-
-	return nil
-}
-
-// UpdateTags updates kinesisvideo service tags.
-// It is called from outside this package.
-func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier string, oldTags, newTags any) error {
-	return updateTags(ctx, meta.(*conns.AWSClient).KinesisVideoClient(ctx), identifier, oldTags, newTags)
-}
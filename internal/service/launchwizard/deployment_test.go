@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/launchwizard"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/launchwizard/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tflaunchwizard "github.com/hashicorp/terraform-provider-aws/internal/service/launchwizard"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Launch Wizard deployments provision complete reference architectures whose
+// specifications depend on the workload, so the pattern and its specifications
+// (as a JSON object) are supplied via environment variables.
+type deploymentTestVars struct {
+	deploymentPatternName string
+	specifications        string
+	workloadName          string
+}
+
+func testAccDeploymentTestVars(t *testing.T) deploymentTestVars {
+	t.Helper()
+
+	return deploymentTestVars{
+		deploymentPatternName: acctest.SkipIfEnvVarNotSet(t, "AWS_LAUNCHWIZARD_DEPLOYMENT_PATTERN_NAME"),
+		specifications:        acctest.SkipIfEnvVarNotSet(t, "AWS_LAUNCHWIZARD_SPECIFICATIONS"),
+		workloadName:          acctest.SkipIfEnvVarNotSet(t, "AWS_LAUNCHWIZARD_WORKLOAD_NAME"),
+	}
+}
+
+func TestAccLaunchWizardDeployment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	vars := testAccDeploymentTestVars(t)
+	var v awstypes.DeploymentData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_launchwizard_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LaunchWizardServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, vars),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, "deployment_pattern_name", vars.deploymentPatternName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.DeploymentStatusCompleted)),
+					resource.TestCheckResourceAttr(resourceName, "workload_name", vars.workloadName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"specifications"},
+			},
+		},
+	})
+}
+
+func TestAccLaunchWizardDeployment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	vars := testAccDeploymentTestVars(t)
+	var v awstypes.DeploymentData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_launchwizard_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LaunchWizardServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, vars),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tflaunchwizard.ResourceDeployment, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLaunchWizardDeployment_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	vars := testAccDeploymentTestVars(t)
+	var v awstypes.DeploymentData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_launchwizard_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LaunchWizardServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_tags1(rName, vars, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_tags2(rName, vars, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_tags1(rName, vars, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LaunchWizardClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_launchwizard_deployment" {
+				continue
+			}
+
+			_, err := tflaunchwizard.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Launch Wizard Deployment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDeploymentExists(ctx context.Context, n string, v *awstypes.DeploymentData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LaunchWizardClient(ctx)
+
+		output, err := tflaunchwizard.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LaunchWizardClient(ctx)
+
+	input := launchwizard.ListWorkloadsInput{}
+	_, err := conn.ListWorkloads(ctx, &input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccDeploymentConfig_basic(rName string, vars deploymentTestVars) string {
+	return fmt.Sprintf(`
+resource "aws_launchwizard_deployment" "test" {
+  deployment_pattern_name = %[2]q
+  name                    = %[1]q
+  specifications          = jsondecode(%[3]q)
+  workload_name           = %[4]q
+}
+`, rName, vars.deploymentPatternName, vars.specifications, vars.workloadName)
+}
+
+func testAccDeploymentConfig_tags1(rName string, vars deploymentTestVars, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_launchwizard_deployment" "test" {
+  deployment_pattern_name = %[2]q
+  name                    = %[1]q
+  specifications          = jsondecode(%[3]q)
+  workload_name           = %[4]q
+
+  tags = {
+    %[5]q = %[6]q
+  }
+}
+`, rName, vars.deploymentPatternName, vars.specifications, vars.workloadName, tagKey1, tagValue1)
+}
+
+func testAccDeploymentConfig_tags2(rName string, vars deploymentTestVars, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_launchwizard_deployment" "test" {
+  deployment_pattern_name = %[2]q
+  name                    = %[1]q
+  specifications          = jsondecode(%[3]q)
+  workload_name           = %[4]q
+
+  tags = {
+    %[5]q = %[6]q
+    %[7]q = %[8]q
+  }
+}
+`, rName, vars.deploymentPatternName, vars.specifications, vars.workloadName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,11 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard
+
+// Exports for use in tests only.
+var (
+	ResourceDeployment = newDeploymentResource
+
+	FindDeploymentByID = findDeploymentByID
+)
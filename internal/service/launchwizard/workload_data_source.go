@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/launchwizard"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/launchwizard/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_launchwizard_workload", name="Workload")
+func newWorkloadDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &workloadDataSource{}, nil
+}
+
+type workloadDataSource struct {
+	framework.DataSourceWithModel[workloadDataSourceModel]
+}
+
+func (d *workloadDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Computed: true,
+			},
+			"documentation_url": schema.StringAttribute{
+				Computed: true,
+			},
+			"icon_url": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadStatus](),
+				Computed:   true,
+			},
+			names.AttrStatusMessage: schema.StringAttribute{
+				Computed: true,
+			},
+			"workload_name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *workloadDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data workloadDataSourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().LaunchWizardClient(ctx)
+
+	name := data.WorkloadName.ValueString()
+	output, err := findWorkloadByName(ctx, conn, name)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func findWorkloadByName(ctx context.Context, conn *launchwizard.Client, name string) (*awstypes.WorkloadData, error) {
+	input := launchwizard.GetWorkloadInput{
+		WorkloadName: aws.String(name),
+	}
+	output, err := conn.GetWorkload(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Workload == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	return output.Workload, nil
+}
+
+type workloadDataSourceModel struct {
+	framework.WithRegionModel
+	Description      types.String                                `tfsdk:"description"`
+	DisplayName      types.String                                `tfsdk:"display_name"`
+	DocumentationURL types.String                                `tfsdk:"documentation_url"`
+	IconURL          types.String                                `tfsdk:"icon_url"`
+	Status           fwtypes.StringEnum[awstypes.WorkloadStatus] `tfsdk:"status"`
+	StatusMessage    types.String                                `tfsdk:"status_message"`
+	WorkloadName     types.String                                `tfsdk:"workload_name"`
+}
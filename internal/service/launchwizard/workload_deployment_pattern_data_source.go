@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/launchwizard"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/launchwizard/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_launchwizard_workload_deployment_pattern", name="Workload Deployment Pattern")
+func newWorkloadDeploymentPatternDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &workloadDeploymentPatternDataSource{}, nil
+}
+
+type workloadDeploymentPatternDataSource struct {
+	framework.DataSourceWithModel[workloadDeploymentPatternDataSourceModel]
+}
+
+func (d *workloadDeploymentPatternDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"deployment_pattern_name": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Computed: true,
+			},
+			"specifications": framework.DataSourceComputedListOfObjectAttribute[deploymentSpecificationsFieldModel](ctx),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadDeploymentPatternStatus](),
+				Computed:   true,
+			},
+			names.AttrStatusMessage: schema.StringAttribute{
+				Computed: true,
+			},
+			"workload_name": schema.StringAttribute{
+				Required: true,
+			},
+			"workload_version_name": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *workloadDeploymentPatternDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data workloadDeploymentPatternDataSourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().LaunchWizardClient(ctx)
+
+	workloadName, patternName := data.WorkloadName.ValueString(), data.DeploymentPatternName.ValueString()
+	output, err := findWorkloadDeploymentPatternByTwoPartKey(ctx, conn, workloadName, patternName)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, patternName)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func findWorkloadDeploymentPatternByTwoPartKey(ctx context.Context, conn *launchwizard.Client, workloadName, patternName string) (*awstypes.WorkloadDeploymentPatternData, error) {
+	input := launchwizard.GetWorkloadDeploymentPatternInput{
+		DeploymentPatternName: aws.String(patternName),
+		WorkloadName:          aws.String(workloadName),
+	}
+	output, err := conn.GetWorkloadDeploymentPattern(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.WorkloadDeploymentPattern == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	return output.WorkloadDeploymentPattern, nil
+}
+
+type workloadDeploymentPatternDataSourceModel struct {
+	framework.WithRegionModel
+	DeploymentPatternName types.String                                                        `tfsdk:"deployment_pattern_name"`
+	Description           types.String                                                        `tfsdk:"description"`
+	DisplayName           types.String                                                        `tfsdk:"display_name"`
+	Specifications        fwtypes.ListNestedObjectValueOf[deploymentSpecificationsFieldModel] `tfsdk:"specifications"`
+	Status                fwtypes.StringEnum[awstypes.WorkloadDeploymentPatternStatus]        `tfsdk:"status"`
+	StatusMessage         types.String                                                        `tfsdk:"status_message"`
+	WorkloadName          types.String                                                        `tfsdk:"workload_name"`
+	WorkloadVersionName   types.String                                                        `tfsdk:"workload_version_name"`
+}
+
+type deploymentSpecificationsFieldModel struct {
+	AllowedValues fwtypes.ListOfString                                             `tfsdk:"allowed_values"`
+	Conditionals  fwtypes.ListNestedObjectValueOf[deploymentConditionalFieldModel] `tfsdk:"conditionals"`
+	Description   types.String                                                     `tfsdk:"description"`
+	Name          types.String                                                     `tfsdk:"name"`
+	Required      types.String                                                     `tfsdk:"required"`
+}
+
+type deploymentConditionalFieldModel struct {
+	Comparator types.String `tfsdk:"comparator"`
+	Name       types.String `tfsdk:"name"`
+	Value      types.String `tfsdk:"value"`
+}
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLaunchWizardWorkloadDeploymentPatternDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_launchwizard_workload_deployment_pattern.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LaunchWizardServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadDeploymentPatternDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "deployment_pattern_name", "SapHanaSingle"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrDisplayName),
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "specifications.#", 1),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(dataSourceName, "workload_name", "SAP"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "workload_version_name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccWorkloadDeploymentPatternDataSourceConfig_basic = `
+data "aws_launchwizard_workload_deployment_pattern" "test" {
+  deployment_pattern_name = "SapHanaSingle"
+  workload_name           = "SAP"
+}
+`
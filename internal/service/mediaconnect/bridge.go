@@ -0,0 +1,852 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediaconnect_bridge", name="Bridge")
+func newBridgeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &bridgeResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type bridgeResource struct {
+	framework.ResourceWithModel[bridgeResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (r *bridgeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"bridge_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BridgeState](),
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"placement_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"egress_gateway_bridge": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[egressGatewayBridgeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"instance_id": schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"max_bitrate": schema.Int32Attribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"ingress_gateway_bridge": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[ingressGatewayBridgeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"instance_id": schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"max_bitrate": schema.Int32Attribute{
+							Required: true,
+						},
+						"max_outputs": schema.Int32Attribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeOutputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"network_output": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeNetworkOutputModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrIPAddress: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"network_name": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrPort: schema.Int32Attribute{
+										Required: true,
+									},
+									names.AttrProtocol: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+										Required:   true,
+									},
+									"ttl": schema.Int32Attribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrSource: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"flow_source": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeFlowSourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"flow_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"output_arn": schema.StringAttribute{
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"flow_vpc_interface_attachment": vpcInterfaceAttachmentBlock(ctx),
+								},
+							},
+						},
+						"network_source": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeNetworkSourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"multicast_ip": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"network_name": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrPort: schema.Int32Attribute{
+										Required: true,
+									},
+									names.AttrProtocol: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"multicast_source_settings": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[multicastSourceSettingsModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"multicast_source_ip": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"source_failover_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[failoverConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"failover_mode": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.FailoverMode](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"recovery_window": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrState: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.State](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"source_priority": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sourcePriorityModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"primary_source": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *bridgeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data bridgeResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	name := data.Name.ValueString()
+	var input mediaconnect.CreateBridgeInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateBridge(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	arn := aws.ToString(output.Bridge.BridgeArn)
+	bridge, err := waitBridgeCreated(ctx, conn, arn, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), arn) // Set 'id' so as to taint the resource.
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, data.flatten(ctx, bridge))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *bridgeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data bridgeResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := data.ID.ValueString()
+	output, err := findBridgeByARN(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, data.flatten(ctx, output))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *bridgeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old bridgeResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := new.ID.ValueString()
+
+	if !new.EgressGatewayBridge.Equal(old.EgressGatewayBridge) || !new.IngressGatewayBridge.Equal(old.IngressGatewayBridge) || !new.SourceFailoverConfig.Equal(old.SourceFailoverConfig) {
+		input := mediaconnect.UpdateBridgeInput{
+			BridgeArn: aws.String(arn),
+		}
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new.EgressGatewayBridge, &input.EgressGatewayBridge))
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new.IngressGatewayBridge, &input.IngressGatewayBridge))
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new.SourceFailoverConfig, &input.SourceFailoverConfig))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Removing the source failover configuration disables failover.
+		if new.SourceFailoverConfig.IsNull() && !old.SourceFailoverConfig.IsNull() {
+			input.SourceFailoverConfig = &awstypes.UpdateFailoverConfig{
+				State: awstypes.StateDisabled,
+			}
+		}
+
+		_, err := conn.UpdateBridge(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	if !new.Sources.Equal(old.Sources) {
+		smerr.AddEnrich(ctx, &response.Diagnostics, updateBridgeSources(ctx, conn, arn, new.Sources, old.Sources))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !new.Outputs.Equal(old.Outputs) {
+		smerr.AddEnrich(ctx, &response.Diagnostics, updateBridgeOutputs(ctx, conn, arn, new.Outputs, old.Outputs))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	bridge, err := waitBridgeUpdated(ctx, conn, arn, r.UpdateTimeout(ctx, new.Timeouts))
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, new.flatten(ctx, bridge))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *bridgeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data bridgeResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := data.ID.ValueString()
+	input := mediaconnect.DeleteBridgeInput{
+		BridgeArn: aws.String(arn),
+	}
+	_, err := conn.DeleteBridge(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	if _, err := waitBridgeDeleted(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+}
+
+func updateBridgeSources(ctx context.Context, conn *mediaconnect.Client, arn string, new, old fwtypes.ListNestedObjectValueOf[bridgeSourceModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	newSources, d := new.ToSlice(ctx)
+	diags.Append(d...)
+	oldSources, d := old.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// A source can't change between flow and network source in-place.
+	sameSource := func(n, o *bridgeSourceModel) bool {
+		return n.name(ctx) == o.name(ctx) && n.FlowSource.IsNull() == o.FlowSource.IsNull()
+	}
+
+	for _, o := range oldSources {
+		if slices.ContainsFunc(newSources, func(n *bridgeSourceModel) bool { return sameSource(n, o) }) {
+			continue
+		}
+
+		input := mediaconnect.RemoveBridgeSourceInput{
+			BridgeArn:  aws.String(arn),
+			SourceName: aws.String(o.name(ctx)),
+		}
+		if _, err := conn.RemoveBridgeSource(ctx, &input); err != nil {
+			diags.AddError("removing MediaConnect Bridge source", err.Error())
+			return diags
+		}
+	}
+
+	var add []awstypes.AddBridgeSourceRequest
+	for _, n := range newSources {
+		i := slices.IndexFunc(oldSources, func(o *bridgeSourceModel) bool { return sameSource(n, o) })
+		if i == -1 {
+			var apiObject awstypes.AddBridgeSourceRequest
+			diags.Append(fwflex.Expand(ctx, n, &apiObject)...)
+			if diags.HasError() {
+				return diags
+			}
+			add = append(add, apiObject)
+			continue
+		}
+
+		o := oldSources[i]
+		diff, d := fwflex.Diff(ctx, n, o)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		if !diff.HasChanges() {
+			continue
+		}
+
+		var input mediaconnect.UpdateBridgeSourceInput
+		diags.Append(fwflex.Expand(ctx, n, &input)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		input.BridgeArn = aws.String(arn)
+		input.SourceName = aws.String(n.name(ctx))
+
+		if _, err := conn.UpdateBridgeSource(ctx, &input); err != nil {
+			diags.AddError("updating MediaConnect Bridge source", err.Error())
+			return diags
+		}
+	}
+
+	if len(add) > 0 {
+		input := mediaconnect.AddBridgeSourcesInput{
+			BridgeArn: aws.String(arn),
+			Sources:   add,
+		}
+		if _, err := conn.AddBridgeSources(ctx, &input); err != nil {
+			diags.AddError("adding MediaConnect Bridge sources", err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func updateBridgeOutputs(ctx context.Context, conn *mediaconnect.Client, arn string, new, old fwtypes.ListNestedObjectValueOf[bridgeOutputModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	newOutputs, d := new.ToSlice(ctx)
+	diags.Append(d...)
+	oldOutputs, d := old.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, o := range oldOutputs {
+		if slices.ContainsFunc(newOutputs, func(n *bridgeOutputModel) bool { return n.name(ctx) == o.name(ctx) }) {
+			continue
+		}
+
+		input := mediaconnect.RemoveBridgeOutputInput{
+			BridgeArn:  aws.String(arn),
+			OutputName: aws.String(o.name(ctx)),
+		}
+		if _, err := conn.RemoveBridgeOutput(ctx, &input); err != nil {
+			diags.AddError("removing MediaConnect Bridge output", err.Error())
+			return diags
+		}
+	}
+
+	var add []awstypes.AddBridgeOutputRequest
+	for _, n := range newOutputs {
+		i := slices.IndexFunc(oldOutputs, func(o *bridgeOutputModel) bool { return o.name(ctx) == n.name(ctx) })
+		if i == -1 {
+			var apiObject awstypes.AddBridgeOutputRequest
+			diags.Append(fwflex.Expand(ctx, n, &apiObject)...)
+			if diags.HasError() {
+				return diags
+			}
+			add = append(add, apiObject)
+			continue
+		}
+
+		if n.NetworkOutput.Equal(oldOutputs[i].NetworkOutput) {
+			continue
+		}
+
+		var input mediaconnect.UpdateBridgeOutputInput
+		diags.Append(fwflex.Expand(ctx, n, &input)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		input.BridgeArn = aws.String(arn)
+		input.OutputName = aws.String(n.name(ctx))
+
+		if _, err := conn.UpdateBridgeOutput(ctx, &input); err != nil {
+			diags.AddError("updating MediaConnect Bridge output", err.Error())
+			return diags
+		}
+	}
+
+	if len(add) > 0 {
+		input := mediaconnect.AddBridgeOutputsInput{
+			BridgeArn: aws.String(arn),
+			Outputs:   add,
+		}
+		if _, err := conn.AddBridgeOutputs(ctx, &input); err != nil {
+			diags.AddError("adding MediaConnect Bridge outputs", err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func findBridgeByARN(ctx context.Context, conn *mediaconnect.Client, arn string) (*awstypes.Bridge, error) {
+	input := mediaconnect.DescribeBridgeInput{
+		BridgeArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeBridge(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Bridge == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	if state := output.Bridge.BridgeState; state == awstypes.BridgeStateDeleted {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: string(state),
+		})
+	}
+
+	return output.Bridge, nil
+}
+
+func statusBridge(conn *mediaconnect.Client, arn string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findBridgeByARN(ctx, conn, arn)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", smarterr.NewError(err)
+		}
+
+		return output, string(output.BridgeState), nil
+	}
+}
+
+func waitBridgeCreated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.BridgeStateCreating, awstypes.BridgeStateStarting, awstypes.BridgeStateDeploying, awstypes.BridgeStateStartPending),
+		Target:     enum.Slice(awstypes.BridgeStateStandby, awstypes.BridgeStateActive),
+		Refresh:    statusBridge(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitBridgeUpdated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.BridgeStateStarting, awstypes.BridgeStateDeploying, awstypes.BridgeStateStartPending),
+		Target:     enum.Slice(awstypes.BridgeStateStandby, awstypes.BridgeStateActive),
+		Refresh:    statusBridge(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitBridgeDeleted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.BridgeStateStandby, awstypes.BridgeStateActive, awstypes.BridgeStateStopping, awstypes.BridgeStateDeleting),
+		Target:     []string{},
+		Refresh:    statusBridge(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+type bridgeResourceModel struct {
+	framework.WithRegionModel
+	ARN                  types.String                                               `tfsdk:"arn"`
+	BridgeState          fwtypes.StringEnum[awstypes.BridgeState]                   `tfsdk:"bridge_state"`
+	EgressGatewayBridge  fwtypes.ListNestedObjectValueOf[egressGatewayBridgeModel]  `tfsdk:"egress_gateway_bridge"`
+	ID                   types.String                                               `tfsdk:"id"`
+	IngressGatewayBridge fwtypes.ListNestedObjectValueOf[ingressGatewayBridgeModel] `tfsdk:"ingress_gateway_bridge"`
+	Name                 types.String                                               `tfsdk:"name"`
+	Outputs              fwtypes.ListNestedObjectValueOf[bridgeOutputModel]         `tfsdk:"output"`
+	PlacementARN         fwtypes.ARN                                                `tfsdk:"placement_arn"`
+	Sources              fwtypes.ListNestedObjectValueOf[bridgeSourceModel]         `tfsdk:"source"`
+	SourceFailoverConfig fwtypes.ListNestedObjectValueOf[failoverConfigModel]       `tfsdk:"source_failover_config"`
+	Timeouts             timeouts.Value                                             `tfsdk:"timeouts"`
+}
+
+func (m *bridgeResourceModel) flatten(ctx context.Context, bridge *awstypes.Bridge) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sourceFailoverConfig := m.SourceFailoverConfig
+	var sourceNames, outputNames []string
+	if sources, d := m.Sources.ToSlice(ctx); !d.HasError() {
+		for _, v := range sources {
+			sourceNames = append(sourceNames, v.name(ctx))
+		}
+	}
+	if outputs, d := m.Outputs.ToSlice(ctx); !d.HasError() {
+		for _, v := range outputs {
+			outputNames = append(outputNames, v.name(ctx))
+		}
+	}
+
+	// Keep sources and outputs in configuration order.
+	bridge.Sources = sortByName(bridge.Sources, bridgeSourceName, sourceNames)
+	bridge.Outputs = sortByName(bridge.Outputs, bridgeOutputName, outputNames)
+	diags.Append(fwflex.Flatten(ctx, bridge, m, fwflex.WithFieldNamePrefix("Bridge"))...)
+	if diags.HasError() {
+		return diags
+	}
+	m.ID = m.ARN
+
+	// MediaConnect returns a (disabled) source failover configuration if none is specified.
+	if sourceFailoverConfig.IsNull() && (bridge.SourceFailoverConfig == nil || bridge.SourceFailoverConfig.State != awstypes.StateEnabled) {
+		m.SourceFailoverConfig = sourceFailoverConfig
+	}
+
+	return diags
+}
+
+func bridgeSourceName(apiObject awstypes.BridgeSource) string {
+	if v := apiObject.FlowSource; v != nil {
+		return aws.ToString(v.Name)
+	}
+	if v := apiObject.NetworkSource; v != nil {
+		return aws.ToString(v.Name)
+	}
+	return ""
+}
+
+func bridgeOutputName(apiObject awstypes.BridgeOutput) string {
+	if v := apiObject.NetworkOutput; v != nil {
+		return aws.ToString(v.Name)
+	}
+	if v := apiObject.FlowOutput; v != nil {
+		return aws.ToString(v.Name)
+	}
+	return ""
+}
+
+type egressGatewayBridgeModel struct {
+	InstanceID types.String `tfsdk:"instance_id"`
+	MaxBitrate types.Int32  `tfsdk:"max_bitrate"`
+}
+
+type ingressGatewayBridgeModel struct {
+	InstanceID types.String `tfsdk:"instance_id"`
+	MaxBitrate types.Int32  `tfsdk:"max_bitrate"`
+	MaxOutputs types.Int32  `tfsdk:"max_outputs"`
+}
+
+type bridgeOutputModel struct {
+	NetworkOutput fwtypes.ListNestedObjectValueOf[bridgeNetworkOutputModel] `tfsdk:"network_output"`
+}
+
+func (m *bridgeOutputModel) name(ctx context.Context) string {
+	if v, d := m.NetworkOutput.ToPtr(ctx); !d.HasError() && v != nil {
+		return v.Name.ValueString()
+	}
+	return ""
+}
+
+type bridgeNetworkOutputModel struct {
+	IPAddress   types.String                          `tfsdk:"ip_address"`
+	Name        types.String                          `tfsdk:"name"`
+	NetworkName types.String                          `tfsdk:"network_name"`
+	Port        types.Int32                           `tfsdk:"port"`
+	Protocol    fwtypes.StringEnum[awstypes.Protocol] `tfsdk:"protocol"`
+	TTL         types.Int32                           `tfsdk:"ttl"`
+}
+
+type bridgeSourceModel struct {
+	FlowSource    fwtypes.ListNestedObjectValueOf[bridgeFlowSourceModel]    `tfsdk:"flow_source"`
+	NetworkSource fwtypes.ListNestedObjectValueOf[bridgeNetworkSourceModel] `tfsdk:"network_source"`
+}
+
+func (m *bridgeSourceModel) name(ctx context.Context) string {
+	if v, d := m.FlowSource.ToPtr(ctx); !d.HasError() && v != nil {
+		return v.Name.ValueString()
+	}
+	if v, d := m.NetworkSource.ToPtr(ctx); !d.HasError() && v != nil {
+		return v.Name.ValueString()
+	}
+	return ""
+}
+
+type bridgeFlowSourceModel struct {
+	FlowARN                    fwtypes.ARN                                                  `tfsdk:"flow_arn"`
+	FlowVPCInterfaceAttachment fwtypes.ListNestedObjectValueOf[vpcInterfaceAttachmentModel] `tfsdk:"flow_vpc_interface_attachment"`
+	Name                       types.String                                                 `tfsdk:"name"`
+	OutputARN                  types.String                                                 `tfsdk:"output_arn"`
+}
+
+type bridgeNetworkSourceModel struct {
+	MulticastIP             types.String                                                  `tfsdk:"multicast_ip"`
+	MulticastSourceSettings fwtypes.ListNestedObjectValueOf[multicastSourceSettingsModel] `tfsdk:"multicast_source_settings"`
+	Name                    types.String                                                  `tfsdk:"name"`
+	NetworkName             types.String                                                  `tfsdk:"network_name"`
+	Port                    types.Int32                                                   `tfsdk:"port"`
+	Protocol                fwtypes.StringEnum[awstypes.Protocol]                         `tfsdk:"protocol"`
+}
+
+type multicastSourceSettingsModel struct {
+	MulticastSourceIP types.String `tfsdk:"multicast_source_ip"`
+}
@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Bridges must be placed on a gateway that has at least one registered instance,
+// which cannot be provisioned by the provider.
+const envVarGatewayARN = "MEDIACONNECT_GATEWAY_ARN"
+
+func TestAccMediaConnectBridge_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	gatewayARN := acctest.SkipIfEnvVarNotSet(t, envVarGatewayARN)
+	var v awstypes.Bridge
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_bridge.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBridgeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBridgeConfig_basic(rName, gatewayARN, 10000000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBridgeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "bridge_state", string(awstypes.BridgeStateActive)),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "ingress_gateway_bridge.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ingress_gateway_bridge.0.max_bitrate", "10000000"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "placement_arn", gatewayARN),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.network_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.network_source.0.name", "source1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBridgeConfig_basic(rName, gatewayARN, 20000000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBridgeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ingress_gateway_bridge.0.max_bitrate", "20000000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectBridge_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	gatewayARN := acctest.SkipIfEnvVarNotSet(t, envVarGatewayARN)
+	var v awstypes.Bridge
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_bridge.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBridgeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBridgeConfig_basic(rName, gatewayARN, 10000000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBridgeExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfmediaconnect.ResourceBridge, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBridgeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_bridge" {
+				continue
+			}
+
+			_, err := tfmediaconnect.FindBridgeByARN(ctx, conn, rs.Primary.ID)
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Bridge %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBridgeExists(ctx context.Context, n string, v *awstypes.Bridge) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		output, err := tfmediaconnect.FindBridgeByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccBridgeConfig_basic(rName, gatewayARN string, maxBitrate int) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_bridge" "test" {
+  name          = %[1]q
+  placement_arn = %[2]q
+
+  ingress_gateway_bridge {
+    max_bitrate = %[3]d
+    max_outputs = 2
+  }
+
+  source {
+    network_source {
+      name         = "source1"
+      multicast_ip = "224.0.0.1"
+      network_name = "network1"
+      port         = 5000
+      protocol     = "rtp"
+    }
+  }
+}
+`, rName, gatewayARN, maxBitrate)
+}
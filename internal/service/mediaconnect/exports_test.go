@@ -0,0 +1,15 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+// Exports for use in tests only.
+var (
+	ResourceBridge  = newBridgeResource
+	ResourceFlow    = newFlowResource
+	ResourceGateway = newGatewayResource
+
+	FindBridgeByARN  = findBridgeByARN
+	FindFlowByARN    = findFlowByARN
+	FindGatewayByARN = findGatewayByARN
+)
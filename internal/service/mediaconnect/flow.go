@@ -0,0 +1,1498 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediaconnect_flow", name="Flow")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newFlowResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type flowResource struct {
+	framework.ResourceWithModel[flowResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (r *flowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	optionalComputedInt32Attribute := func() schema.Int32Attribute {
+		return schema.Int32Attribute{
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Int32{
+				int32planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	optionalComputedStringAttribute := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	computedStringAttribute := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	encryptionBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"algorithm": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.Algorithm](),
+						Optional:   true,
+					},
+					"constant_initialization_vector": schema.StringAttribute{
+						Optional: true,
+					},
+					"device_id": schema.StringAttribute{
+						Optional: true,
+					},
+					"key_type": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.KeyType](),
+						Optional:   true,
+						Computed:   true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					names.AttrRegion: schema.StringAttribute{
+						Optional: true,
+					},
+					names.AttrResourceID: schema.StringAttribute{
+						Optional: true,
+					},
+					names.AttrRoleARN: schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Required:   true,
+					},
+					"secret_arn": schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Optional:   true,
+					},
+					names.AttrURL: schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		}
+	}
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrAvailabilityZone: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"egress_ip":  computedStringAttribute(),
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Status](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"entitlement": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowEntitlementModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"data_transfer_subscriber_fee_percent": optionalComputedInt32Attribute(),
+						names.AttrDescription:                  optionalComputedStringAttribute(),
+						"entitlement_arn":                      computedStringAttribute(),
+						"entitlement_status": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.EntitlementStatus](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"subscribers": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption": encryptionBlock(),
+					},
+				},
+			},
+			"maintenance": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[maintenanceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"maintenance_day": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.MaintenanceDay](),
+							Required:   true,
+						},
+						"maintenance_start_hour": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"media_stream": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[mediaStreamModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"clock_rate":          optionalComputedInt32Attribute(),
+						names.AttrDescription: optionalComputedStringAttribute(),
+						"fmt": schema.Int32Attribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						"media_stream_id": schema.Int32Attribute{
+							Required: true,
+						},
+						"media_stream_name": schema.StringAttribute{
+							Required: true,
+						},
+						"media_stream_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.MediaStreamType](),
+							Required:   true,
+						},
+						"video_format": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrAttributes: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[mediaStreamAttributesModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"lang": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"fmtp": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[fmtpModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"channel_order": schema.StringAttribute{
+													Optional: true,
+												},
+												"colorimetry": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.Colorimetry](),
+													Optional:   true,
+												},
+												"exact_framerate": schema.StringAttribute{
+													Optional: true,
+												},
+												"par": schema.StringAttribute{
+													Optional: true,
+												},
+												"range": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.Range](),
+													Optional:   true,
+												},
+												"scan_mode": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ScanMode](),
+													Optional:   true,
+												},
+												"tcs": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.Tcs](),
+													Optional:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowOutputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr_allow_list": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrDescription: optionalComputedStringAttribute(),
+						names.AttrDestination: schema.StringAttribute{
+							Optional: true,
+						},
+						"listener_address": computedStringAttribute(),
+						"max_latency":      optionalComputedInt32Attribute(),
+						"min_latency":      optionalComputedInt32Attribute(),
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"output_arn": computedStringAttribute(),
+						"output_status": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.OutputStatus](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrPort: optionalComputedInt32Attribute(),
+						names.AttrProtocol: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+							Optional:   true,
+						},
+						"remote_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"sender_control_port": schema.Int32Attribute{
+							Optional: true,
+						},
+						"smoothing_latency": optionalComputedInt32Attribute(),
+						"stream_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption":               encryptionBlock(),
+						"vpc_interface_attachment": vpcInterfaceAttachmentBlock(ctx),
+					},
+				},
+			},
+			names.AttrSource: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 2),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDescription: optionalComputedStringAttribute(),
+						"entitlement_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						"ingest_ip":       computedStringAttribute(),
+						"ingest_port":     optionalComputedInt32Attribute(),
+						"max_bitrate":     optionalComputedInt32Attribute(),
+						"max_latency":     optionalComputedInt32Attribute(),
+						"max_sync_buffer": optionalComputedInt32Attribute(),
+						"min_latency":     optionalComputedInt32Attribute(),
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplaceIf(
+									requiresReplaceIfSourceRenamedWithoutFailover,
+									"Renaming a source without source failover enabled requires the flow to be replaced",
+									"Renaming a source without source failover enabled requires the flow to be replaced",
+								),
+							},
+						},
+						names.AttrProtocol: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+							Optional:   true,
+						},
+						"sender_control_port": schema.Int32Attribute{
+							Optional: true,
+						},
+						"sender_ip_address": schema.StringAttribute{
+							Optional: true,
+						},
+						"source_arn": computedStringAttribute(),
+						"source_listener_address": schema.StringAttribute{
+							Optional: true,
+						},
+						"source_listener_port": schema.Int32Attribute{
+							Optional: true,
+						},
+						"stream_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"vpc_interface_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"whitelist_cidr": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"decryption": encryptionBlock(),
+					},
+				},
+			},
+			"source_failover_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[failoverConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"failover_mode": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.FailoverMode](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"recovery_window": optionalComputedInt32Attribute(),
+						names.AttrState: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.State](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"source_priority": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sourcePriorityModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"primary_source": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"vpc_interface": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vpcInterfaceModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"network_interface_ids": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Computed:    true,
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"network_interface_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.NetworkInterfaceType](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						names.AttrSubnetID: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func vpcInterfaceAttachmentBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[vpcInterfaceAttachmentModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"vpc_interface_name": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *flowResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data flowResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Sources.IsUnknown() || data.SourceFailoverConfig.IsUnknown() {
+		return
+	}
+
+	sources, d := data.Sources.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() || len(sources) < 2 {
+		return
+	}
+
+	// A flow can only have two sources when source failover is enabled.
+	enabled, d := sourceFailoverEnabled(ctx, data.SourceFailoverConfig)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !enabled {
+		response.Diagnostics.AddAttributeError(
+			path.Root(names.AttrSource),
+			"Invalid Configuration",
+			"A second source can only be configured when source_failover_config is enabled",
+		)
+	}
+}
+
+// ModifyPlan marks the computed values of a source added or renamed in place as unknown,
+// as UseStateForUnknown copies them from the source previously at the same position.
+func (r *flowResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state flowResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Sources.IsUnknown() {
+		return
+	}
+
+	planSources, d := plan.Sources.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	stateSources, d := state.Sources.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for i, n := range planSources {
+		if i < len(stateSources) && stateSources[i].Name.Equal(n.Name) {
+			continue
+		}
+
+		sourcePath := path.Root(names.AttrSource).AtListIndex(i)
+
+		for _, v := range []string{"ingest_ip", "source_arn"} {
+			smerr.AddEnrich(ctx, &response.Diagnostics, response.Plan.SetAttribute(ctx, sourcePath.AtName(v), types.StringUnknown()))
+		}
+
+		var description types.String
+		smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.GetAttribute(ctx, sourcePath.AtName(names.AttrDescription), &description))
+		if description.IsNull() {
+			smerr.AddEnrich(ctx, &response.Diagnostics, response.Plan.SetAttribute(ctx, sourcePath.AtName(names.AttrDescription), types.StringUnknown()))
+		}
+
+		for _, v := range []string{"ingest_port", "max_bitrate", "max_latency", "max_sync_buffer", "min_latency"} {
+			var value types.Int32
+			smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.GetAttribute(ctx, sourcePath.AtName(v), &value))
+			if value.IsNull() {
+				smerr.AddEnrich(ctx, &response.Diagnostics, response.Plan.SetAttribute(ctx, sourcePath.AtName(v), types.Int32Unknown()))
+			}
+		}
+	}
+}
+
+func (r *flowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	name := data.Name.ValueString()
+	var input mediaconnect.CreateFlowInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.FlowTags = getTagsIn(ctx)
+	// A single source is specified via "Source", multiple (failover) sources via "Sources".
+	if len(input.Sources) == 1 {
+		input.Source, input.Sources = &input.Sources[0], nil
+	}
+
+	output, err := conn.CreateFlow(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	arn := aws.ToString(output.Flow.FlowArn)
+	flow, err := waitFlowCreated(ctx, conn, arn, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), arn) // Set 'id' so as to taint the resource.
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, data.flatten(ctx, flow))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *flowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := data.ID.ValueString()
+	output, err := findFlowByARN(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, data.flatten(ctx, output))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *flowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old flowResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := new.ID.ValueString()
+	diff, d := fwflex.Diff(ctx, new, old)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		newFailoverEnabled, d := sourceFailoverEnabled(ctx, new.SourceFailoverConfig)
+		smerr.AddEnrich(ctx, &response.Diagnostics, d)
+		oldFailoverEnabled, d := sourceFailoverEnabled(ctx, old.SourceFailoverConfig)
+		smerr.AddEnrich(ctx, &response.Diagnostics, d)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Failover can only be disabled once the flow has a single source, so stale sources are removed first.
+		// Otherwise failover is enabled before any second source is added.
+		sourcesFirst := oldFailoverEnabled && !newFailoverEnabled
+
+		if sourcesFirst && !new.Sources.Equal(old.Sources) {
+			smerr.AddEnrich(ctx, &response.Diagnostics, updateFlowSources(ctx, conn, arn, new.Sources, old.Sources))
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		if !new.Maintenance.Equal(old.Maintenance) || !new.SourceFailoverConfig.Equal(old.SourceFailoverConfig) {
+			input := mediaconnect.UpdateFlowInput{
+				FlowArn: aws.String(arn),
+			}
+			smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new.Maintenance, &input.Maintenance))
+			smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new.SourceFailoverConfig, &input.SourceFailoverConfig))
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			// Removing the source failover configuration disables failover.
+			if new.SourceFailoverConfig.IsNull() && !old.SourceFailoverConfig.IsNull() {
+				input.SourceFailoverConfig = &awstypes.UpdateFailoverConfig{
+					State: awstypes.StateDisabled,
+				}
+			}
+
+			_, err := conn.UpdateFlow(ctx, &input)
+
+			if err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+				return
+			}
+		}
+
+		if !sourcesFirst && !new.Sources.Equal(old.Sources) {
+			smerr.AddEnrich(ctx, &response.Diagnostics, updateFlowSources(ctx, conn, arn, new.Sources, old.Sources))
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		if !new.Outputs.Equal(old.Outputs) {
+			smerr.AddEnrich(ctx, &response.Diagnostics, updateFlowOutputs(ctx, conn, arn, new.Outputs, old.Outputs))
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		if !new.Entitlements.Equal(old.Entitlements) {
+			smerr.AddEnrich(ctx, &response.Diagnostics, updateFlowEntitlements(ctx, conn, arn, new.Entitlements, old.Entitlements))
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		flow, err := waitFlowUpdated(ctx, conn, arn, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		// Set values for unknowns.
+		smerr.AddEnrich(ctx, &response.Diagnostics, new.flatten(ctx, flow))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.Status = old.Status
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *flowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := data.ID.ValueString()
+	timeout := r.DeleteTimeout(ctx, data.Timeouts)
+
+	// A flow must be stopped before it can be deleted.
+	if data.Status.ValueEnum() == awstypes.StatusActive || data.Status.ValueEnum() == awstypes.StatusStarting {
+		input := mediaconnect.StopFlowInput{
+			FlowArn: aws.String(arn),
+		}
+		_, err := conn.StopFlow(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			return
+		}
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		if _, err := waitFlowStopped(ctx, conn, arn, timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	input := mediaconnect.DeleteFlowInput{
+		FlowArn: aws.String(arn),
+	}
+	_, err := conn.DeleteFlow(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	if _, err := waitFlowDeleted(ctx, conn, arn, timeout); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+}
+
+// sourceFailoverEnabled returns whether the source failover configuration enables failover.
+// A configuration that doesn't specify a state is treated as enabled.
+func sourceFailoverEnabled(ctx context.Context, v fwtypes.ListNestedObjectValueOf[failoverConfigModel]) (bool, diag.Diagnostics) {
+	failoverConfig, diags := v.ToPtr(ctx)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return failoverConfig != nil && failoverConfig.State.ValueEnum() != awstypes.StateDisabled, diags
+}
+
+// requiresReplaceIfSourceRenamedWithoutFailover requires replacement when an existing source is renamed and failover is
+// not enabled, as the renamed source is added before the old one is removed and a flow can only have two sources with failover.
+func requiresReplaceIfSourceRenamedWithoutFailover(ctx context.Context, request planmodifier.StringRequest, response *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if request.StateValue.IsNull() {
+		return
+	}
+
+	var failoverConfig fwtypes.ListNestedObjectValueOf[failoverConfigModel]
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("source_failover_config"), &failoverConfig)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if failoverConfig.IsUnknown() {
+		response.RequiresReplace = true
+		return
+	}
+
+	enabled, diags := sourceFailoverEnabled(ctx, failoverConfig)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.RequiresReplace = !enabled
+}
+
+func updateFlowSources(ctx context.Context, conn *mediaconnect.Client, arn string, new, old fwtypes.ListNestedObjectValueOf[flowSourceModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	newSources, d := new.ToSlice(ctx)
+	diags.Append(d...)
+	oldSources, d := old.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var add []awstypes.SetSourceRequest
+	for _, n := range newSources {
+		i := slices.IndexFunc(oldSources, func(o *flowSourceModel) bool { return o.Name.Equal(n.Name) })
+		if i == -1 {
+			var apiObject awstypes.SetSourceRequest
+			diags.Append(fwflex.Expand(ctx, n, &apiObject)...)
+			if diags.HasError() {
+				return diags
+			}
+			add = append(add, apiObject)
+			continue
+		}
+
+		o := oldSources[i]
+		diff, d := fwflex.Diff(ctx, n, o)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		if !diff.HasChanges() {
+			continue
+		}
+
+		var input mediaconnect.UpdateFlowSourceInput
+		diags.Append(fwflex.Expand(ctx, n, &input)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		input.FlowArn = aws.String(arn)
+		input.SourceArn = o.SourceARN.ValueStringPointer()
+
+		if _, err := conn.UpdateFlowSource(ctx, &input); err != nil {
+			diags.AddError("updating MediaConnect Flow source", err.Error())
+			return diags
+		}
+	}
+
+	var remove []*flowSourceModel
+	for _, o := range oldSources {
+		if !slices.ContainsFunc(newSources, func(n *flowSourceModel) bool { return n.Name.Equal(o.Name) }) {
+			remove = append(remove, o)
+		}
+	}
+
+	addSources := func() diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if len(add) == 0 {
+			return diags
+		}
+
+		input := mediaconnect.AddFlowSourcesInput{
+			FlowArn: aws.String(arn),
+			Sources: add,
+		}
+		if _, err := conn.AddFlowSources(ctx, &input); err != nil {
+			diags.AddError("adding MediaConnect Flow sources", err.Error())
+		}
+
+		return diags
+	}
+	removeSources := func() diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		for _, o := range remove {
+			input := mediaconnect.RemoveFlowSourceInput{
+				FlowArn:   aws.String(arn),
+				SourceArn: o.SourceARN.ValueStringPointer(),
+			}
+			if _, err := conn.RemoveFlowSource(ctx, &input); err != nil {
+				diags.AddError("removing MediaConnect Flow source", err.Error())
+				return diags
+			}
+		}
+
+		return diags
+	}
+
+	// A flow can't be left without a source, so new sources are added before stale ones are removed
+	// unless that would exceed the maximum of two sources.
+	if len(oldSources)+len(add) > 2 {
+		diags.Append(removeSources()...)
+		if diags.HasError() {
+			return diags
+		}
+		diags.Append(addSources()...)
+	} else {
+		diags.Append(addSources()...)
+		if diags.HasError() {
+			return diags
+		}
+		diags.Append(removeSources()...)
+	}
+
+	return diags
+}
+
+func updateFlowOutputs(ctx context.Context, conn *mediaconnect.Client, arn string, new, old fwtypes.ListNestedObjectValueOf[flowOutputModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	newOutputs, d := new.ToSlice(ctx)
+	diags.Append(d...)
+	oldOutputs, d := old.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, o := range oldOutputs {
+		if slices.ContainsFunc(newOutputs, func(n *flowOutputModel) bool { return n.Name.Equal(o.Name) }) {
+			continue
+		}
+
+		input := mediaconnect.RemoveFlowOutputInput{
+			FlowArn:   aws.String(arn),
+			OutputArn: o.OutputARN.ValueStringPointer(),
+		}
+		if _, err := conn.RemoveFlowOutput(ctx, &input); err != nil {
+			diags.AddError("removing MediaConnect Flow output", err.Error())
+			return diags
+		}
+	}
+
+	var add []awstypes.AddOutputRequest
+	for _, n := range newOutputs {
+		i := slices.IndexFunc(oldOutputs, func(o *flowOutputModel) bool { return o.Name.Equal(n.Name) })
+		if i == -1 {
+			var apiObject awstypes.AddOutputRequest
+			diags.Append(fwflex.Expand(ctx, n, &apiObject)...)
+			if diags.HasError() {
+				return diags
+			}
+			add = append(add, apiObject)
+			continue
+		}
+
+		o := oldOutputs[i]
+		diff, d := fwflex.Diff(ctx, n, o)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		if !diff.HasChanges() {
+			continue
+		}
+
+		var input mediaconnect.UpdateFlowOutputInput
+		diags.Append(fwflex.Expand(ctx, n, &input)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		input.FlowArn = aws.String(arn)
+		input.OutputArn = o.OutputARN.ValueStringPointer()
+
+		if _, err := conn.UpdateFlowOutput(ctx, &input); err != nil {
+			diags.AddError("updating MediaConnect Flow output", err.Error())
+			return diags
+		}
+	}
+
+	if len(add) > 0 {
+		input := mediaconnect.AddFlowOutputsInput{
+			FlowArn: aws.String(arn),
+			Outputs: add,
+		}
+		if _, err := conn.AddFlowOutputs(ctx, &input); err != nil {
+			diags.AddError("adding MediaConnect Flow outputs", err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func updateFlowEntitlements(ctx context.Context, conn *mediaconnect.Client, arn string, new, old fwtypes.ListNestedObjectValueOf[flowEntitlementModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	newEntitlements, d := new.ToSlice(ctx)
+	diags.Append(d...)
+	oldEntitlements, d := old.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The data transfer subscriber fee can't be updated in-place; such entitlements are revoked and re-granted.
+	replace := func(n, o *flowEntitlementModel) bool {
+		return !n.DataTransferSubscriberFeePercent.IsUnknown() && !n.DataTransferSubscriberFeePercent.Equal(o.DataTransferSubscriberFeePercent)
+	}
+
+	for _, o := range oldEntitlements {
+		if slices.ContainsFunc(newEntitlements, func(n *flowEntitlementModel) bool { return n.Name.Equal(o.Name) && !replace(n, o) }) {
+			continue
+		}
+
+		input := mediaconnect.RevokeFlowEntitlementInput{
+			EntitlementArn: o.EntitlementARN.ValueStringPointer(),
+			FlowArn:        aws.String(arn),
+		}
+		if _, err := conn.RevokeFlowEntitlement(ctx, &input); err != nil {
+			diags.AddError("revoking MediaConnect Flow entitlement", err.Error())
+			return diags
+		}
+	}
+
+	var grant []awstypes.GrantEntitlementRequest
+	for _, n := range newEntitlements {
+		i := slices.IndexFunc(oldEntitlements, func(o *flowEntitlementModel) bool { return o.Name.Equal(n.Name) })
+		if i == -1 || replace(n, oldEntitlements[i]) {
+			var apiObject awstypes.GrantEntitlementRequest
+			diags.Append(fwflex.Expand(ctx, n, &apiObject)...)
+			if diags.HasError() {
+				return diags
+			}
+			grant = append(grant, apiObject)
+			continue
+		}
+
+		o := oldEntitlements[i]
+		diff, d := fwflex.Diff(ctx, n, o)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		if !diff.HasChanges() {
+			continue
+		}
+
+		var input mediaconnect.UpdateFlowEntitlementInput
+		diags.Append(fwflex.Expand(ctx, n, &input)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		input.EntitlementArn = o.EntitlementARN.ValueStringPointer()
+		input.FlowArn = aws.String(arn)
+
+		if _, err := conn.UpdateFlowEntitlement(ctx, &input); err != nil {
+			diags.AddError("updating MediaConnect Flow entitlement", err.Error())
+			return diags
+		}
+	}
+
+	if len(grant) > 0 {
+		input := mediaconnect.GrantFlowEntitlementsInput{
+			Entitlements: grant,
+			FlowArn:      aws.String(arn),
+		}
+		if _, err := conn.GrantFlowEntitlements(ctx, &input); err != nil {
+			diags.AddError("granting MediaConnect Flow entitlements", err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func findFlowByARN(ctx context.Context, conn *mediaconnect.Client, arn string) (*awstypes.Flow, error) {
+	input := mediaconnect.DescribeFlowInput{
+		FlowArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeFlow(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Flow == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	return output.Flow, nil
+}
+
+func statusFlow(conn *mediaconnect.Client, arn string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findFlowByARN(ctx, conn, arn)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", smarterr.NewError(err)
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlowCreated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusUpdating),
+		Target:     enum.Slice(awstypes.StatusStandby),
+		Refresh:    statusFlow(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitFlowUpdated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusUpdating),
+		Target:     enum.Slice(awstypes.StatusStandby, awstypes.StatusActive),
+		Refresh:    statusFlow(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitFlowStopped(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusActive, awstypes.StatusStarting, awstypes.StatusStopping, awstypes.StatusUpdating),
+		Target:     enum.Slice(awstypes.StatusStandby),
+		Refresh:    statusFlow(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitFlowDeleted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusStandby, awstypes.StatusDeleting),
+		Target:     []string{},
+		Refresh:    statusFlow(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+// sortByName orders API objects to match the order of the names in the prior state or plan.
+// Objects not present in the prior state or plan are placed at the end.
+func sortByName[T any](apiObjects []T, name func(T) string, names []string) []T {
+	index := func(v T) int {
+		if i := slices.Index(names, name(v)); i != -1 {
+			return i
+		}
+		return len(names)
+	}
+
+	apiObjects = slices.Clone(apiObjects)
+	slices.SortStableFunc(apiObjects, func(a, b T) int {
+		return index(a) - index(b)
+	})
+
+	return apiObjects
+}
+
+type flowResourceModel struct {
+	framework.WithRegionModel
+	ARN                  types.String                                          `tfsdk:"arn"`
+	AvailabilityZone     types.String                                          `tfsdk:"availability_zone"`
+	EgressIP             types.String                                          `tfsdk:"egress_ip"`
+	Entitlements         fwtypes.ListNestedObjectValueOf[flowEntitlementModel] `tfsdk:"entitlement"`
+	ID                   types.String                                          `tfsdk:"id"`
+	Maintenance          fwtypes.ListNestedObjectValueOf[maintenanceModel]     `tfsdk:"maintenance"`
+	MediaStreams         fwtypes.ListNestedObjectValueOf[mediaStreamModel]     `tfsdk:"media_stream"`
+	Name                 types.String                                          `tfsdk:"name"`
+	Outputs              fwtypes.ListNestedObjectValueOf[flowOutputModel]      `tfsdk:"output" autoflex:",noflatten"`
+	Sources              fwtypes.ListNestedObjectValueOf[flowSourceModel]      `tfsdk:"source" autoflex:",noflatten"`
+	SourceFailoverConfig fwtypes.ListNestedObjectValueOf[failoverConfigModel]  `tfsdk:"source_failover_config"`
+	Status               fwtypes.StringEnum[awstypes.Status]                   `tfsdk:"status"`
+	Tags                 tftags.Map                                            `tfsdk:"tags"`
+	TagsAll              tftags.Map                                            `tfsdk:"tags_all"`
+	Timeouts             timeouts.Value                                        `tfsdk:"timeouts"`
+	VPCInterfaces        fwtypes.ListNestedObjectValueOf[vpcInterfaceModel]    `tfsdk:"vpc_interface"`
+}
+
+func (m *flowResourceModel) flatten(ctx context.Context, flow *awstypes.Flow) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	maintenance, sourceFailoverConfig := m.Maintenance, m.SourceFailoverConfig
+	entitlementNames, outputNames := m.entitlementNames(ctx), m.outputNames(ctx)
+
+	// Keep entitlements and outputs in configuration order.
+	flow.Entitlements = sortByName(flow.Entitlements, func(v awstypes.Entitlement) string { return aws.ToString(v.Name) }, entitlementNames)
+	diags.Append(fwflex.Flatten(ctx, flow, m, fwflex.WithFieldNamePrefix("Flow"))...)
+	if diags.HasError() {
+		return diags
+	}
+	m.ID = m.ARN
+
+	// MediaConnect assigns a maintenance window and a (disabled) source failover configuration
+	// if none are specified.
+	if maintenance.IsNull() {
+		m.Maintenance = maintenance
+	}
+	if sourceFailoverConfig.IsNull() && (flow.SourceFailoverConfig == nil || flow.SourceFailoverConfig.State != awstypes.StateEnabled) {
+		m.SourceFailoverConfig = sourceFailoverConfig
+	}
+
+	sources := flow.Sources
+	if len(sources) == 0 && flow.Source != nil {
+		sources = []awstypes.Source{*flow.Source}
+	}
+	sourceModels := make([]*flowSourceModel, 0, len(sources))
+	for _, source := range sources {
+		var sourceModel flowSourceModel
+		diags.Append(fwflex.Flatten(ctx, source, &sourceModel)...)
+		// Protocol-specific settings are returned in the transport.
+		diags.Append(fwflex.Flatten(ctx, transportOrEmpty(source.Transport), &sourceModel)...)
+		if diags.HasError() {
+			return diags
+		}
+		sourceModels = append(sourceModels, &sourceModel)
+	}
+	m.Sources = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, sourceModels)
+
+	outputs := sortByName(flow.Outputs, func(v awstypes.Output) string { return aws.ToString(v.Name) }, outputNames)
+	outputModels := make([]*flowOutputModel, 0, len(outputs))
+	for _, output := range outputs {
+		var outputModel flowOutputModel
+		diags.Append(fwflex.Flatten(ctx, output, &outputModel)...)
+		// Protocol-specific settings are returned in the transport.
+		diags.Append(fwflex.Flatten(ctx, transportOrEmpty(output.Transport), &outputModel)...)
+		if diags.HasError() {
+			return diags
+		}
+		outputModels = append(outputModels, &outputModel)
+	}
+	if len(outputModels) == 0 {
+		m.Outputs = fwtypes.NewListNestedObjectValueOfNull[flowOutputModel](ctx)
+	} else {
+		m.Outputs = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, outputModels)
+	}
+
+	return diags
+}
+
+func (m *flowResourceModel) entitlementNames(ctx context.Context) []string {
+	var names []string
+	if entitlements, diags := m.Entitlements.ToSlice(ctx); !diags.HasError() {
+		for _, v := range entitlements {
+			names = append(names, v.Name.ValueString())
+		}
+	}
+	return names
+}
+
+func (m *flowResourceModel) outputNames(ctx context.Context) []string {
+	var names []string
+	if outputs, diags := m.Outputs.ToSlice(ctx); !diags.HasError() {
+		for _, v := range outputs {
+			names = append(names, v.Name.ValueString())
+		}
+	}
+	return names
+}
+
+func transportOrEmpty(apiObject *awstypes.Transport) *awstypes.Transport {
+	if apiObject == nil {
+		return &awstypes.Transport{}
+	}
+	return apiObject
+}
+
+type encryptionModel struct {
+	Algorithm                    fwtypes.StringEnum[awstypes.Algorithm] `tfsdk:"algorithm"`
+	ConstantInitializationVector types.String                           `tfsdk:"constant_initialization_vector"`
+	DeviceID                     types.String                           `tfsdk:"device_id"`
+	KeyType                      fwtypes.StringEnum[awstypes.KeyType]   `tfsdk:"key_type"`
+	Region                       types.String                           `tfsdk:"region"`
+	ResourceID                   types.String                           `tfsdk:"resource_id"`
+	RoleARN                      fwtypes.ARN                            `tfsdk:"role_arn"`
+	SecretARN                    fwtypes.ARN                            `tfsdk:"secret_arn"`
+	URL                          types.String                           `tfsdk:"url"`
+}
+
+type flowEntitlementModel struct {
+	DataTransferSubscriberFeePercent types.Int32                                      `tfsdk:"data_transfer_subscriber_fee_percent"`
+	Description                      types.String                                     `tfsdk:"description"`
+	Encryption                       fwtypes.ListNestedObjectValueOf[encryptionModel] `tfsdk:"encryption"`
+	EntitlementARN                   types.String                                     `tfsdk:"entitlement_arn"`
+	EntitlementStatus                fwtypes.StringEnum[awstypes.EntitlementStatus]   `tfsdk:"entitlement_status"`
+	Name                             types.String                                     `tfsdk:"name"`
+	Subscribers                      fwtypes.SetOfString                              `tfsdk:"subscribers"`
+}
+
+type maintenanceModel struct {
+	MaintenanceDay       fwtypes.StringEnum[awstypes.MaintenanceDay] `tfsdk:"maintenance_day"`
+	MaintenanceStartHour types.String                                `tfsdk:"maintenance_start_hour"`
+}
+
+type mediaStreamModel struct {
+	Attributes      fwtypes.ListNestedObjectValueOf[mediaStreamAttributesModel] `tfsdk:"attributes"`
+	ClockRate       types.Int32                                                 `tfsdk:"clock_rate"`
+	Description     types.String                                                `tfsdk:"description"`
+	Fmt             types.Int32                                                 `tfsdk:"fmt"`
+	MediaStreamID   types.Int32                                                 `tfsdk:"media_stream_id"`
+	MediaStreamName types.String                                                `tfsdk:"media_stream_name"`
+	MediaStreamType fwtypes.StringEnum[awstypes.MediaStreamType]                `tfsdk:"media_stream_type"`
+	VideoFormat     types.String                                                `tfsdk:"video_format"`
+}
+
+type mediaStreamAttributesModel struct {
+	Fmtp fwtypes.ListNestedObjectValueOf[fmtpModel] `tfsdk:"fmtp"`
+	Lang types.String                               `tfsdk:"lang"`
+}
+
+type fmtpModel struct {
+	ChannelOrder   types.String                             `tfsdk:"channel_order"`
+	Colorimetry    fwtypes.StringEnum[awstypes.Colorimetry] `tfsdk:"colorimetry"`
+	ExactFramerate types.String                             `tfsdk:"exact_framerate"`
+	Par            types.String                             `tfsdk:"par"`
+	Range          fwtypes.StringEnum[awstypes.Range]       `tfsdk:"range"`
+	ScanMode       fwtypes.StringEnum[awstypes.ScanMode]    `tfsdk:"scan_mode"`
+	Tcs            fwtypes.StringEnum[awstypes.Tcs]         `tfsdk:"tcs"`
+}
+
+type flowOutputModel struct {
+	CIDRAllowList          fwtypes.ListOfString                                         `tfsdk:"cidr_allow_list"`
+	Description            types.String                                                 `tfsdk:"description"`
+	Destination            types.String                                                 `tfsdk:"destination"`
+	Encryption             fwtypes.ListNestedObjectValueOf[encryptionModel]             `tfsdk:"encryption"`
+	ListenerAddress        types.String                                                 `tfsdk:"listener_address"`
+	MaxLatency             types.Int32                                                  `tfsdk:"max_latency"`
+	MinLatency             types.Int32                                                  `tfsdk:"min_latency"`
+	Name                   types.String                                                 `tfsdk:"name"`
+	OutputARN              types.String                                                 `tfsdk:"output_arn"`
+	OutputStatus           fwtypes.StringEnum[awstypes.OutputStatus]                    `tfsdk:"output_status"`
+	Port                   types.Int32                                                  `tfsdk:"port"`
+	Protocol               fwtypes.StringEnum[awstypes.Protocol]                        `tfsdk:"protocol"`
+	RemoteID               types.String                                                 `tfsdk:"remote_id"`
+	SenderControlPort      types.Int32                                                  `tfsdk:"sender_control_port"`
+	SmoothingLatency       types.Int32                                                  `tfsdk:"smoothing_latency"`
+	StreamID               types.String                                                 `tfsdk:"stream_id"`
+	VPCInterfaceAttachment fwtypes.ListNestedObjectValueOf[vpcInterfaceAttachmentModel] `tfsdk:"vpc_interface_attachment"`
+}
+
+type flowSourceModel struct {
+	Decryption            fwtypes.ListNestedObjectValueOf[encryptionModel] `tfsdk:"decryption"`
+	Description           types.String                                     `tfsdk:"description"`
+	EntitlementARN        fwtypes.ARN                                      `tfsdk:"entitlement_arn"`
+	IngestIP              types.String                                     `tfsdk:"ingest_ip"`
+	IngestPort            types.Int32                                      `tfsdk:"ingest_port"`
+	MaxBitrate            types.Int32                                      `tfsdk:"max_bitrate"`
+	MaxLatency            types.Int32                                      `tfsdk:"max_latency"`
+	MaxSyncBuffer         types.Int32                                      `tfsdk:"max_sync_buffer"`
+	MinLatency            types.Int32                                      `tfsdk:"min_latency"`
+	Name                  types.String                                     `tfsdk:"name"`
+	Protocol              fwtypes.StringEnum[awstypes.Protocol]            `tfsdk:"protocol"`
+	SenderControlPort     types.Int32                                      `tfsdk:"sender_control_port"`
+	SenderIPAddress       types.String                                     `tfsdk:"sender_ip_address"`
+	SourceARN             types.String                                     `tfsdk:"source_arn"`
+	SourceListenerAddress types.String                                     `tfsdk:"source_listener_address"`
+	SourceListenerPort    types.Int32                                      `tfsdk:"source_listener_port"`
+	StreamID              types.String                                     `tfsdk:"stream_id"`
+	VPCInterfaceName      types.String                                     `tfsdk:"vpc_interface_name"`
+	WhitelistCIDR         types.String                                     `tfsdk:"whitelist_cidr"`
+}
+
+type failoverConfigModel struct {
+	FailoverMode   fwtypes.StringEnum[awstypes.FailoverMode]            `tfsdk:"failover_mode"`
+	RecoveryWindow types.Int32                                          `tfsdk:"recovery_window"`
+	SourcePriority fwtypes.ListNestedObjectValueOf[sourcePriorityModel] `tfsdk:"source_priority"`
+	State          fwtypes.StringEnum[awstypes.State]                   `tfsdk:"state"`
+}
+
+type sourcePriorityModel struct {
+	PrimarySource types.String `tfsdk:"primary_source"`
+}
+
+type vpcInterfaceModel struct {
+	Name                 types.String                                      `tfsdk:"name"`
+	NetworkInterfaceIDs  fwtypes.ListOfString                              `tfsdk:"network_interface_ids"`
+	NetworkInterfaceType fwtypes.StringEnum[awstypes.NetworkInterfaceType] `tfsdk:"network_interface_type"`
+	RoleARN              fwtypes.ARN                                       `tfsdk:"role_arn"`
+	SecurityGroupIDs     fwtypes.SetOfString                               `tfsdk:"security_group_ids"`
+	SubnetID             types.String                                      `tfsdk:"subnet_id"`
+}
+
+type vpcInterfaceAttachmentModel struct {
+	VPCInterfaceName types.String `tfsdk:"vpc_interface_name"`
+}
@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	flowStateChangePollInterval     = 5 * time.Second
+	flowStateChangeProgressInterval = 30 * time.Second
+)
+
+// @Action(aws_mediaconnect_start_flow, name="Start Flow")
+func newStartFlowAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startFlowAction{}, nil
+}
+
+var (
+	_ action.Action = (*startFlowAction)(nil)
+)
+
+type startFlowAction struct {
+	framework.ActionWithModel[flowStateChangeActionModel]
+}
+
+type flowStateChangeActionModel struct {
+	framework.WithRegionModel
+	FlowARN fwtypes.ARN `tfsdk:"flow_arn"`
+	Timeout types.Int64 `tfsdk:"timeout"`
+}
+
+func flowStateChangeActionSchema(description, verb string) schema.Schema {
+	return schema.Schema{
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"flow_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: fmt.Sprintf("The ARN of the MediaConnect flow to %s.", verb),
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: fmt.Sprintf("Timeout in seconds to wait for the flow to %s (default: 600)", verb),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(30, 3600),
+				},
+			},
+		},
+	}
+}
+
+func (a *startFlowAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = flowStateChangeActionSchema("Starts an AWS Elemental MediaConnect flow and waits for it to become active.", "start")
+}
+
+func (a *startFlowAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config flowStateChangeActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().MediaConnectClient(ctx)
+
+	flowARN := config.FlowARN.ValueString()
+
+	timeout := 600 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting MediaConnect start flow action", map[string]any{
+		"flow_arn":        flowARN,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	flow, err := findFlowByARN(ctx, conn, flowARN)
+	if err != nil {
+		if retry.NotFound(err) {
+			resp.Diagnostics.AddError(
+				"Flow Not Found",
+				fmt.Sprintf("MediaConnect flow %s was not found", flowARN),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Describe Flow",
+			fmt.Sprintf("Could not describe MediaConnect flow %s: %s", flowARN, err),
+		)
+		return
+	}
+
+	switch flow.Status {
+	case awstypes.StatusActive:
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("MediaConnect flow %s is already active", flowARN),
+		})
+		return
+	case awstypes.StatusStarting:
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("MediaConnect flow %s is already starting, waiting for completion...", flowARN),
+		})
+	case awstypes.StatusStandby:
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Starting MediaConnect flow %s...", flowARN),
+		})
+
+		input := mediaconnect.StartFlowInput{
+			FlowArn: aws.String(flowARN),
+		}
+		if _, err := conn.StartFlow(ctx, &input); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Start Flow",
+				fmt.Sprintf("Could not start MediaConnect flow %s: %s", flowARN, err),
+			)
+			return
+		}
+	default:
+		resp.Diagnostics.AddError(
+			"Cannot Start Flow",
+			fmt.Sprintf("MediaConnect flow %s is in status '%s' and cannot be started. Flow must be in 'STANDBY' or 'STARTING' status.", flowARN, flow.Status),
+		)
+		return
+	}
+
+	err = waitFlowStatusForAction(ctx, conn, flowARN, timeout, awstypes.StatusActive, []awstypes.Status{awstypes.StatusStandby, awstypes.StatusStarting, awstypes.StatusUpdating}, resp)
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Flow to Start",
+				fmt.Sprintf("MediaConnect flow %s did not become active within %s: %s", flowARN, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) || errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Flow Status",
+				fmt.Sprintf("MediaConnect flow %s entered unexpected status while starting: %s", flowARN, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Flow to Start",
+				fmt.Sprintf("Error while waiting for MediaConnect flow %s to start: %s", flowARN, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("MediaConnect flow %s has been successfully started", flowARN),
+	})
+
+	tflog.Info(ctx, "MediaConnect start flow action completed successfully", map[string]any{
+		"flow_arn": flowARN,
+	})
+}
+
+// waitFlowStatusForAction polls the flow until it reaches the target status, sending periodic progress updates.
+func waitFlowStatusForAction(ctx context.Context, conn *mediaconnect.Client, flowARN string, timeout time.Duration, target awstypes.Status, transitional []awstypes.Status, resp *action.InvokeResponse) error {
+	transitionalStates := make([]actionwait.Status, 0, len(transitional))
+	for _, v := range transitional {
+		transitionalStates = append(transitionalStates, actionwait.Status(v))
+	}
+
+	_, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		flow, err := findFlowByARN(ctx, conn, flowARN)
+		if err != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("describing flow: %w", err)
+		}
+		return actionwait.FetchResult[struct{}]{Status: actionwait.Status(flow.Status)}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(flowStateChangePollInterval),
+		ProgressInterval:   flowStateChangeProgressInterval,
+		SuccessStates:      []actionwait.Status{actionwait.Status(target)},
+		TransitionalStates: transitionalStates,
+		FailureStates:      []actionwait.Status{actionwait.Status(awstypes.StatusError)},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("MediaConnect flow %s is currently in status '%s', continuing to wait for '%s'...", flowARN, fr.Status, target)})
+		},
+	})
+
+	return err
+}
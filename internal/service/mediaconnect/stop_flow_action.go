@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_mediaconnect_stop_flow, name="Stop Flow")
+func newStopFlowAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &stopFlowAction{}, nil
+}
+
+var (
+	_ action.Action = (*stopFlowAction)(nil)
+)
+
+type stopFlowAction struct {
+	framework.ActionWithModel[flowStateChangeActionModel]
+}
+
+func (a *stopFlowAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = flowStateChangeActionSchema("Stops an AWS Elemental MediaConnect flow and waits for it to reach standby.", "stop")
+}
+
+func (a *stopFlowAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config flowStateChangeActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().MediaConnectClient(ctx)
+
+	flowARN := config.FlowARN.ValueString()
+
+	timeout := 600 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting MediaConnect stop flow action", map[string]any{
+		"flow_arn":        flowARN,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	flow, err := findFlowByARN(ctx, conn, flowARN)
+	if err != nil {
+		if retry.NotFound(err) {
+			resp.Diagnostics.AddError(
+				"Flow Not Found",
+				fmt.Sprintf("MediaConnect flow %s was not found", flowARN),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Describe Flow",
+			fmt.Sprintf("Could not describe MediaConnect flow %s: %s", flowARN, err),
+		)
+		return
+	}
+
+	switch flow.Status {
+	case awstypes.StatusStandby:
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("MediaConnect flow %s is already stopped", flowARN),
+		})
+		return
+	case awstypes.StatusStopping:
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("MediaConnect flow %s is already stopping, waiting for completion...", flowARN),
+		})
+	case awstypes.StatusActive, awstypes.StatusStarting:
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Stopping MediaConnect flow %s...", flowARN),
+		})
+
+		input := mediaconnect.StopFlowInput{
+			FlowArn: aws.String(flowARN),
+		}
+		if _, err := conn.StopFlow(ctx, &input); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Stop Flow",
+				fmt.Sprintf("Could not stop MediaConnect flow %s: %s", flowARN, err),
+			)
+			return
+		}
+	default:
+		resp.Diagnostics.AddError(
+			"Cannot Stop Flow",
+			fmt.Sprintf("MediaConnect flow %s is in status '%s' and cannot be stopped. Flow must be in 'ACTIVE', 'STARTING' or 'STOPPING' status.", flowARN, flow.Status),
+		)
+		return
+	}
+
+	err = waitFlowStatusForAction(ctx, conn, flowARN, timeout, awstypes.StatusStandby, []awstypes.Status{awstypes.StatusActive, awstypes.StatusStarting, awstypes.StatusStopping, awstypes.StatusUpdating}, resp)
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Flow to Stop",
+				fmt.Sprintf("MediaConnect flow %s did not stop within %s: %s", flowARN, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) || errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Flow Status",
+				fmt.Sprintf("MediaConnect flow %s entered unexpected status while stopping: %s", flowARN, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Flow to Stop",
+				fmt.Sprintf("Error while waiting for MediaConnect flow %s to stop: %s", flowARN, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("MediaConnect flow %s has been successfully stopped", flowARN),
+	})
+
+	tflog.Info(ctx, "MediaConnect stop flow action completed successfully", map[string]any{
+		"flow_arn": flowARN,
+	})
+}
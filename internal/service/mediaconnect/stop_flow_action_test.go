@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConnectStopFlowAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Flow
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartFlowActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					testAccCheckFlowStatus(ctx, resourceName, awstypes.StatusActive),
+				),
+			},
+			{
+				Config: testAccStopFlowActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowStatus(ctx, resourceName, awstypes.StatusStandby),
+				),
+			},
+		},
+	})
+}
+
+func testAccStopFlowActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStartFlowActionConfig_basic(rName), `
+action "aws_mediaconnect_stop_flow" "test" {
+  config {
+    flow_arn = aws_mediaconnect_flow.test.arn
+    timeout  = 900
+  }
+}
+
+resource "terraform_data" "stop" {
+  input = "stop"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_mediaconnect_stop_flow.test]
+    }
+  }
+
+  depends_on = [terraform_data.trigger]
+}
+`)
+}
@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagevod
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagevod"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediapackagevod/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediapackagevod_asset", name="Asset")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newAssetResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &assetResource{}
+
+	return r, nil
+}
+
+type assetResource struct {
+	framework.ResourceWithModel[assetResourceModel]
+	framework.WithImportByID
+}
+
+func (r *assetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"asset_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"egress_endpoints": schema.ListNestedAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[egressEndpointModel](ctx),
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"packaging_configuration_id": schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrStatus: schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrURL: schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"packaging_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrResourceID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *assetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data assetResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := data.AssetID.ValueString()
+	var input mediapackagevod.CreateAssetInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Id = aws.String(id)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateAsset(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *assetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data assetResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := data.ID.ValueString()
+	output, err := findAssetByID(ctx, conn, id)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.AssetID = fwflex.StringToFramework(ctx, output.Id)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *assetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data assetResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := data.ID.ValueString()
+	input := mediapackagevod.DeleteAssetInput{
+		Id: aws.String(id),
+	}
+	_, err := conn.DeleteAsset(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func findAssetByID(ctx context.Context, conn *mediapackagevod.Client, id string) (*mediapackagevod.DescribeAssetOutput, error) {
+	input := mediapackagevod.DescribeAssetInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribeAsset(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	return output, nil
+}
+
+type assetResourceModel struct {
+	framework.WithRegionModel
+	ARN              types.String                                         `tfsdk:"arn"`
+	AssetID          types.String                                         `tfsdk:"asset_id"`
+	CreatedAt        types.String                                         `tfsdk:"created_at"`
+	EgressEndpoints  fwtypes.ListNestedObjectValueOf[egressEndpointModel] `tfsdk:"egress_endpoints"`
+	ID               types.String                                         `tfsdk:"id"`
+	PackagingGroupID types.String                                         `tfsdk:"packaging_group_id"`
+	ResourceID       types.String                                         `tfsdk:"resource_id"`
+	SourceARN        fwtypes.ARN                                          `tfsdk:"source_arn"`
+	SourceRoleARN    fwtypes.ARN                                          `tfsdk:"source_role_arn"`
+	Tags             tftags.Map                                           `tfsdk:"tags"`
+	TagsAll          tftags.Map                                           `tfsdk:"tags_all"`
+}
+
+type egressEndpointModel struct {
+	PackagingConfigurationID types.String `tfsdk:"packaging_configuration_id"`
+	Status                   types.String `tfsdk:"status"`
+	URL                      types.String `tfsdk:"url"`
+}
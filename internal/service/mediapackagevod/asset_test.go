@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagevod_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagevod"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfmediapackagevod "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagevod"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Assets are ingested from an existing HLS, MP4 or SMIL source in S3
+// which must be provided out of band.
+const envVarAssetSourceARN = "MEDIAPACKAGEVOD_ASSET_SOURCE_ARN"
+
+func TestAccMediaPackageVODAsset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	sourceARN := acctest.SkipIfEnvVarNotSet(t, envVarAssetSourceARN)
+	var v mediapackagevod.DescribeAssetOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagevod_asset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_basic(rName, sourceARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "mediapackage-vod", regexache.MustCompile(`assets/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "asset_id", rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, "egress_endpoints.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "egress_endpoints.0.packaging_configuration_id", "aws_mediapackagevod_packaging_configuration.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "packaging_group_id", "aws_mediapackagevod_packaging_group.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrResourceID),
+					resource.TestCheckResourceAttr(resourceName, "source_arn", sourceARN),
+					resource.TestCheckResourceAttrPair(resourceName, "source_role_arn", "aws_iam_role.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"egress_endpoints"},
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODAsset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	sourceARN := acctest.SkipIfEnvVarNotSet(t, envVarAssetSourceARN)
+	var v mediapackagevod.DescribeAssetOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagevod_asset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_basic(rName, sourceARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfmediapackagevod.ResourceAsset, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageVODClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediapackagevod_asset" {
+				continue
+			}
+
+			_, err := tfmediapackagevod.FindAssetByID(ctx, conn, rs.Primary.ID)
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaPackage VOD Asset %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAssetExists(ctx context.Context, n string, v *mediapackagevod.DescribeAssetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageVODClient(ctx)
+
+		output, err := tfmediapackagevod.FindAssetByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAssetConfig_basic(rName, sourceARN string) string {
+	return acctest.ConfigCompose(testAccPackagingConfigurationConfig_hls(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "mediapackage.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:GetObject", "s3:GetBucketLocation", "s3:GetBucketRequestPayment", "s3:ListBucket"]
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_mediapackagevod_asset" "test" {
+  asset_id           = %[1]q
+  packaging_group_id = aws_mediapackagevod_packaging_group.test.id
+  source_arn         = %[2]q
+  source_role_arn    = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy.test, aws_mediapackagevod_packaging_configuration.test]
+}
+`, rName, sourceARN))
+}
@@ -0,0 +1,15 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagevod
+
+// Exports for use in tests only.
+var (
+	ResourceAsset                  = newAssetResource
+	ResourcePackagingConfiguration = newPackagingConfigurationResource
+	ResourcePackagingGroup         = newPackagingGroupResource
+
+	FindAssetByID                  = findAssetByID
+	FindPackagingConfigurationByID = findPackagingConfigurationByID
+	FindPackagingGroupByID         = findPackagingGroupByID
+)
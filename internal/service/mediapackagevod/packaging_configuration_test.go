@@ -0,0 +1,409 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagevod_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagevod"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfmediapackagevod "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagevod"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaPackageVODPackagingConfiguration_hls(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingConfigurationOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagevod_packaging_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingConfigurationConfig_hls(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingConfigurationExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "mediapackage-vod", regexache.MustCompile(`packaging-configurations/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.0.hls_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.0.hls_manifest.0.ad_markers", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.0.hls_manifest.0.manifest_name", "index"),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.0.segment_duration_seconds", "6"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, rName),
+					resource.TestCheckResourceAttr(resourceName, "mss_package.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "packaging_configuration_id", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "packaging_group_id", "aws_mediapackagevod_packaging_group.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODPackagingConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingConfigurationOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagevod_packaging_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingConfigurationConfig_hls(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfmediapackagevod.ResourcePackagingConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODPackagingConfiguration_dash(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingConfigurationOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagevod_packaging_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingConfigurationConfig_dash(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.dash_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.dash_manifest.0.manifest_layout", "COMPACT"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.dash_manifest.0.stream_selection.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.dash_manifest.0.stream_selection.0.stream_order", "VIDEO_BITRATE_DESCENDING"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.period_triggers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.period_triggers.0", "ADS"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.segment_template_format", "NUMBER_WITH_TIMELINE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODPackagingConfiguration_cmaf(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingConfigurationOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagevod_packaging_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingConfigurationConfig_cmaf(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.hls_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.hls_manifest.0.include_iframe_only_stream", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.include_encoder_configuration_in_segments", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.segment_duration_seconds", "4"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODPackagingConfiguration_mss(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingConfigurationOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagevod_packaging_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingConfigurationConfig_mss(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "mss_package.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mss_package.0.mss_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mss_package.0.mss_manifest.0.manifest_name", "index"),
+					resource.TestCheckResourceAttr(resourceName, "mss_package.0.segment_duration_seconds", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODPackagingConfiguration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingConfigurationOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagevod_packaging_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingConfigurationConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackagingConfigurationConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccPackagingConfigurationConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPackagingConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageVODClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediapackagevod_packaging_configuration" {
+				continue
+			}
+
+			_, err := tfmediapackagevod.FindPackagingConfigurationByID(ctx, conn, rs.Primary.ID)
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaPackage VOD Packaging Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPackagingConfigurationExists(ctx context.Context, n string, v *mediapackagevod.DescribePackagingConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageVODClient(ctx)
+
+		output, err := tfmediapackagevod.FindPackagingConfigurationByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPackagingConfigurationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mediapackagevod_packaging_group" "test" {
+  packaging_group_id = %[1]q
+}
+`, rName)
+}
+
+func testAccPackagingConfigurationConfig_hls(rName string) string {
+	return acctest.ConfigCompose(testAccPackagingConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_mediapackagevod_packaging_configuration" "test" {
+  packaging_configuration_id = %[1]q
+  packaging_group_id         = aws_mediapackagevod_packaging_group.test.id
+
+  hls_package {
+    hls_manifest {
+      ad_markers    = "NONE"
+      manifest_name = "index"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccPackagingConfigurationConfig_dash(rName string) string {
+	return acctest.ConfigCompose(testAccPackagingConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_mediapackagevod_packaging_configuration" "test" {
+  packaging_configuration_id = %[1]q
+  packaging_group_id         = aws_mediapackagevod_packaging_group.test.id
+
+  dash_package {
+    period_triggers         = ["ADS"]
+    segment_template_format = "NUMBER_WITH_TIMELINE"
+
+    dash_manifest {
+      manifest_layout = "COMPACT"
+
+      stream_selection {
+        stream_order = "VIDEO_BITRATE_DESCENDING"
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccPackagingConfigurationConfig_cmaf(rName string) string {
+	return acctest.ConfigCompose(testAccPackagingConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_mediapackagevod_packaging_configuration" "test" {
+  packaging_configuration_id = %[1]q
+  packaging_group_id         = aws_mediapackagevod_packaging_group.test.id
+
+  cmaf_package {
+    include_encoder_configuration_in_segments = true
+    segment_duration_seconds                  = 4
+
+    hls_manifest {
+      include_iframe_only_stream = true
+    }
+  }
+}
+`, rName))
+}
+
+func testAccPackagingConfigurationConfig_mss(rName string) string {
+	return acctest.ConfigCompose(testAccPackagingConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_mediapackagevod_packaging_configuration" "test" {
+  packaging_configuration_id = %[1]q
+  packaging_group_id         = aws_mediapackagevod_packaging_group.test.id
+
+  mss_package {
+    segment_duration_seconds = 2
+
+    mss_manifest {
+      manifest_name = "index"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccPackagingConfigurationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPackagingConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_mediapackagevod_packaging_configuration" "test" {
+  packaging_configuration_id = %[1]q
+  packaging_group_id         = aws_mediapackagevod_packaging_group.test.id
+
+  hls_package {
+    hls_manifest {}
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccPackagingConfigurationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPackagingConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_mediapackagevod_packaging_configuration" "test" {
+  packaging_configuration_id = %[1]q
+  packaging_group_id         = aws_mediapackagevod_packaging_group.test.id
+
+  hls_package {
+    hls_manifest {}
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
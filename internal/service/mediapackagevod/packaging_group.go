@@ -0,0 +1,335 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagevod
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagevod"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediapackagevod/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediapackagevod_packaging_group", name="Packaging Group")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newPackagingGroupResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &packagingGroupResource{}
+
+	return r, nil
+}
+
+type packagingGroupResource struct {
+	framework.ResourceWithModel[packagingGroupResourceModel]
+	framework.WithImportByID
+}
+
+func (r *packagingGroupResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"approximate_asset_count": schema.Int32Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDomainName: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"packaging_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"authorization": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[authorizationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cdn_identifier_secret": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"secrets_role_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"egress_access_logs": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[egressAccessLogsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrLogGroupName: schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *packagingGroupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data packagingGroupResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := data.PackagingGroupID.ValueString()
+	var input mediapackagevod.CreatePackagingGroupInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Id = aws.String(id)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreatePackagingGroup(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *packagingGroupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data packagingGroupResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := data.ID.ValueString()
+	output, err := findPackagingGroupByID(ctx, conn, id)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.PackagingGroupID = fwflex.StringToFramework(ctx, output.Id)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *packagingGroupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old packagingGroupResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := new.ID.ValueString()
+
+	if !new.Authorization.Equal(old.Authorization) {
+		input := mediapackagevod.UpdatePackagingGroupInput{
+			Id: aws.String(id),
+		}
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new.Authorization, &input.Authorization))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// A nil value leaves the authorization unchanged, so send empty values to remove it.
+		if new.Authorization.IsNull() {
+			input.Authorization = &awstypes.Authorization{
+				CdnIdentifierSecret: aws.String(""),
+				SecretsRoleArn:      aws.String(""),
+			}
+		}
+
+		_, err := conn.UpdatePackagingGroup(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	if !new.EgressAccessLogs.Equal(old.EgressAccessLogs) {
+		input := mediapackagevod.ConfigureLogsInput{
+			Id: aws.String(id),
+		}
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new.EgressAccessLogs, &input.EgressAccessLogs))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.ConfigureLogs(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	output, err := findPackagingGroupByID(ctx, conn, id)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *packagingGroupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data packagingGroupResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageVODClient(ctx)
+
+	id := data.ID.ValueString()
+	input := mediapackagevod.DeletePackagingGroupInput{
+		Id: aws.String(id),
+	}
+	_, err := conn.DeletePackagingGroup(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func findPackagingGroupByID(ctx context.Context, conn *mediapackagevod.Client, id string) (*mediapackagevod.DescribePackagingGroupOutput, error) {
+	input := mediapackagevod.DescribePackagingGroupInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribePackagingGroup(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	// A removed authorization may be returned with empty values.
+	if v := output.Authorization; v != nil && aws.ToString(v.CdnIdentifierSecret) == "" && aws.ToString(v.SecretsRoleArn) == "" {
+		output.Authorization = nil
+	}
+
+	return output, nil
+}
+
+type packagingGroupResourceModel struct {
+	framework.WithRegionModel
+	ApproximateAssetCount types.Int32                                            `tfsdk:"approximate_asset_count"`
+	ARN                   types.String                                           `tfsdk:"arn"`
+	Authorization         fwtypes.ListNestedObjectValueOf[authorizationModel]    `tfsdk:"authorization"`
+	CreatedAt             types.String                                           `tfsdk:"created_at"`
+	DomainName            types.String                                           `tfsdk:"domain_name"`
+	EgressAccessLogs      fwtypes.ListNestedObjectValueOf[egressAccessLogsModel] `tfsdk:"egress_access_logs"`
+	ID                    types.String                                           `tfsdk:"id"`
+	PackagingGroupID      types.String                                           `tfsdk:"packaging_group_id"`
+	Tags                  tftags.Map                                             `tfsdk:"tags"`
+	TagsAll               tftags.Map                                             `tfsdk:"tags_all"`
+}
+
+type authorizationModel struct {
+	CDNIdentifierSecret fwtypes.ARN `tfsdk:"cdn_identifier_secret"`
+	SecretsRoleARN      fwtypes.ARN `tfsdk:"secrets_role_arn"`
+}
+
+type egressAccessLogsModel struct {
+	LogGroupName types.String `tfsdk:"log_group_name"`
+}
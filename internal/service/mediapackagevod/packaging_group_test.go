@@ -0,0 +1,397 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagevod_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagevod"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfmediapackagevod "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagevod"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaPackageVODPackagingGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingGroupOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagevod_packaging_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "approximate_asset_count", "0"),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "mediapackage-vod", regexache.MustCompile(`packaging-groups/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "authorization.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrDomainName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, rName),
+					resource.TestCheckResourceAttr(resourceName, "packaging_group_id", rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODPackagingGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingGroupOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagevod_packaging_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingGroupExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfmediapackagevod.ResourcePackagingGroup, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODPackagingGroup_egressAccessLogs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingGroupOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagevod_packaging_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingGroupConfig_egressAccessLogs(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "egress_access_logs.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "egress_access_logs.0.log_group_name", "aws_cloudwatch_log_group.test", names.AttrName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackagingGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "egress_access_logs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODPackagingGroup_authorization(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingGroupOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagevod_packaging_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "authorization.#", "0"),
+				),
+			},
+			{
+				Config: testAccPackagingGroupConfig_authorization(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "authorization.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "authorization.0.cdn_identifier_secret", "aws_secretsmanager_secret.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "authorization.0.secrets_role_arn", "aws_iam_role.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackagingGroupConfig_basic(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "authorization.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageVODPackagingGroup_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagevod.DescribePackagingGroupOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagevod_packaging_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageVODServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackagingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackagingGroupConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackagingGroupConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccPackagingGroupConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackagingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPackagingGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageVODClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediapackagevod_packaging_group" {
+				continue
+			}
+
+			_, err := tfmediapackagevod.FindPackagingGroupByID(ctx, conn, rs.Primary.ID)
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaPackage VOD Packaging Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPackagingGroupExists(ctx context.Context, n string, v *mediapackagevod.DescribePackagingGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageVODClient(ctx)
+
+		output, err := tfmediapackagevod.FindPackagingGroupByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageVODClient(ctx)
+
+	input := mediapackagevod.ListPackagingGroupsInput{}
+	_, err := conn.ListPackagingGroups(ctx, &input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccPackagingGroupConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mediapackagevod_packaging_group" "test" {
+  packaging_group_id = %[1]q
+}
+`, rName)
+}
+
+func testAccPackagingGroupConfig_egressAccessLogs(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = "/aws/MediaPackage/%[1]s"
+}
+
+resource "aws_mediapackagevod_packaging_group" "test" {
+  packaging_group_id = %[1]q
+
+  egress_access_logs {
+    log_group_name = aws_cloudwatch_log_group.test.name
+  }
+}
+`, rName)
+}
+
+func testAccPackagingGroupConfig_authorization(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({ MediaPackageCDNIdentifier = "b4b2e2b6-7ec8-4f2e-9b5f-1e0f9f6f4c2d" })
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "mediapackage.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "secretsmanager:GetSecretValue",
+        "secretsmanager:DescribeSecret",
+        "secretsmanager:ListSecrets",
+        "secretsmanager:ListSecretVersionIds",
+      ]
+      Resource = aws_secretsmanager_secret.test.arn
+    }]
+  })
+}
+
+resource "aws_mediapackagevod_packaging_group" "test" {
+  packaging_group_id = %[1]q
+
+  authorization {
+    cdn_identifier_secret = aws_secretsmanager_secret.test.arn
+    secrets_role_arn      = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_secretsmanager_secret_version.test]
+}
+`, rName)
+}
+
+func testAccPackagingGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_mediapackagevod_packaging_group" "test" {
+  packaging_group_id = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccPackagingGroupConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_mediapackagevod_packaging_group" "test" {
+  packaging_group_id = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,305 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_application", name="Application")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn/types;awstypes;awstypes.Application")
+// @Testing(tagsTest=false)
+func newApplicationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &applicationResource{}
+
+	return r, nil
+}
+
+type applicationResource struct {
+	framework.ResourceWithModel[applicationResourceModel]
+	framework.WithImportByID
+}
+
+func (r *applicationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"wave_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+var applicationFlexOpt = flex.WithFieldNamePrefix(ResNameApplication)
+
+func (r *applicationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	var input mgn.CreateApplicationInput
+	response.Diagnostics.Append(flex.Expand(ctx, data, &input, applicationFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateApplication(ctx, &input)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionCreating, ResNameApplication, data.Name.ValueString(), err)
+
+		return
+	}
+
+	id := aws.ToString(output.ApplicationID)
+	data.ARN = flex.StringToFramework(ctx, output.Arn)
+	data.ID = types.StringValue(id)
+
+	if waveID := data.WaveID.ValueString(); waveID != "" {
+		if err := associateApplication(ctx, conn, id, waveID); err != nil {
+			create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionCreating, ResNameApplication, id, err)
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *applicationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	output, err := findApplicationByID(ctx, conn, data.ID.ValueString())
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionReading, ResNameApplication, data.ID.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data, applicationFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *applicationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new applicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	id := new.ID.ValueString()
+
+	if !new.Description.Equal(old.Description) || !new.Name.Equal(old.Name) {
+		input := mgn.UpdateApplicationInput{
+			ApplicationID: aws.String(id),
+			Description:   new.Description.ValueStringPointer(),
+			Name:          new.Name.ValueStringPointer(),
+		}
+
+		_, err := conn.UpdateApplication(ctx, &input)
+
+		if err != nil {
+			create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionUpdating, ResNameApplication, id, err)
+
+			return
+		}
+	}
+
+	if !new.WaveID.Equal(old.WaveID) {
+		if waveID := old.WaveID.ValueString(); waveID != "" {
+			if err := disassociateApplication(ctx, conn, id, waveID); err != nil {
+				create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionUpdating, ResNameApplication, id, err)
+
+				return
+			}
+		}
+
+		if waveID := new.WaveID.ValueString(); waveID != "" {
+			if err := associateApplication(ctx, conn, id, waveID); err != nil {
+				create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionUpdating, ResNameApplication, id, err)
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *applicationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	id := data.ID.ValueString()
+
+	if waveID := data.WaveID.ValueString(); waveID != "" {
+		err := disassociateApplication(ctx, conn, id, waveID)
+
+		if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionDeleting, ResNameApplication, id, err)
+
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "deleting MGN Application", map[string]any{
+		names.AttrID: id,
+	})
+
+	input := mgn.DeleteApplicationInput{
+		ApplicationID: aws.String(id),
+	}
+
+	_, err := conn.DeleteApplication(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionDeleting, ResNameApplication, id, err)
+
+		return
+	}
+}
+
+func associateApplication(ctx context.Context, conn *mgn.Client, applicationID, waveID string) error {
+	input := mgn.AssociateApplicationsInput{
+		ApplicationIDs: []string{applicationID},
+		WaveID:         aws.String(waveID),
+	}
+
+	_, err := conn.AssociateApplications(ctx, &input)
+
+	return err
+}
+
+func disassociateApplication(ctx context.Context, conn *mgn.Client, applicationID, waveID string) error {
+	input := mgn.DisassociateApplicationsInput{
+		ApplicationIDs: []string{applicationID},
+		WaveID:         aws.String(waveID),
+	}
+
+	_, err := conn.DisassociateApplications(ctx, &input)
+
+	return err
+}
+
+func findApplication(ctx context.Context, conn *mgn.Client, input *mgn.ListApplicationsInput) (*awstypes.Application, error) {
+	output, err := findApplications(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findApplications(ctx context.Context, conn *mgn.Client, input *mgn.ListApplicationsInput) ([]awstypes.Application, error) {
+	var output []awstypes.Application
+
+	pages := mgn.NewListApplicationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+func findApplicationByID(ctx context.Context, conn *mgn.Client, id string) (*awstypes.Application, error) {
+	input := mgn.ListApplicationsInput{
+		Filters: &awstypes.ListApplicationsRequestFilters{
+			ApplicationIDs: []string{id},
+		},
+	}
+
+	return findApplication(ctx, conn, &input)
+}
+
+type applicationResourceModel struct {
+	framework.WithRegionModel
+	ARN         types.String `tfsdk:"arn"`
+	Description types.String `tfsdk:"description"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Tags        tftags.Map   `tfsdk:"tags"`
+	TagsAll     tftags.Map   `tfsdk:"tags_all"`
+	WaveID      types.String `tfsdk:"wave_id"`
+}
@@ -0,0 +1,12 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+const (
+	ResNameApplication                      = "Application"
+	ResNameLaunchConfigurationTemplate      = "Launch Configuration Template"
+	ResNameReplicationConfigurationTemplate = "Replication Configuration Template"
+	ResNameSourceServerLaunchConfiguration  = "Source Server Launch Configuration"
+	ResNameWave                             = "Wave"
+)
@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+// Exports for use in tests only.
+var (
+	ResourceApplication                      = newApplicationResource
+	ResourceLaunchConfigurationTemplate      = newLaunchConfigurationTemplateResource
+	ResourceReplicationConfigurationTemplate = newReplicationConfigurationTemplateResource
+	ResourceSourceServerLaunchConfiguration  = newSourceServerLaunchConfigurationResource
+	ResourceWave                             = newWaveResource
+
+	FindApplicationByID                      = findApplicationByID
+	FindLaunchConfigurationBySourceServerID  = findLaunchConfigurationBySourceServerID
+	FindLaunchConfigurationTemplateByID      = findLaunchConfigurationTemplateByID
+	FindReplicationConfigurationTemplateByID = findReplicationConfigurationTemplateByID
+	FindWaveByID                             = findWaveByID
+)
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Source servers are registered by installing the replication agent
+// and cannot be created via the API.
+const envVarSourceServerID = "MGN_SOURCE_SERVER_ID"
+
+func TestAccMgnSourceServerLaunchConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	sourceServerID := acctest.SkipIfEnvVarNotSet(t, envVarSourceServerID)
+	resourceName := "aws_mgn_source_server_launch_configuration.test"
+	var v mgn.GetLaunchConfigurationOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceServerLaunchConfigurationConfig_basic(sourceServerID, "STOPPED", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSourceServerLaunchConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "copy_tags", acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(resourceName, "ec2_launch_template_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, sourceServerID),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "source_server_id", sourceServerID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSourceServerLaunchConfigurationConfig_basic(sourceServerID, "STARTED", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSourceServerLaunchConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "copy_tags", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STARTED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSourceServerLaunchConfigurationExists(ctx context.Context, n string, v *mgn.GetLaunchConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MgnClient(ctx)
+
+		output, err := tfmgn.FindLaunchConfigurationBySourceServerID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSourceServerLaunchConfigurationConfig_basic(sourceServerID, launchDisposition string, copyTags bool) string {
+	return fmt.Sprintf(`
+resource "aws_mgn_source_server_launch_configuration" "test" {
+  source_server_id   = %[1]q
+  copy_tags          = %[3]t
+  launch_disposition = %[2]q
+}
+`, sourceServerID, launchDisposition, copyTags)
+}
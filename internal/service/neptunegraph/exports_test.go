@@ -5,7 +5,11 @@ package neptunegraph
 
 // Exports for use in tests only.
 var (
-	ResourceGraph = newGraphResource
+	ResourceGraph                = newGraphResource
+	ResourceGraphSnapshot        = newGraphSnapshotResource
+	ResourcePrivateGraphEndpoint = newPrivateGraphEndpointResource
 
-	FindGraphByID = findGraphByID
+	FindGraphByID                        = findGraphByID
+	FindGraphSnapshotByID                = findGraphSnapshotByID
+	FindPrivateGraphEndpointByTwoPartKey = findPrivateGraphEndpointByTwoPartKey
 )
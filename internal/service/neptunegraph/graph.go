@@ -143,6 +143,19 @@ func (r *graphResource) Schema(ctx context.Context, request resource.SchemaReque
 					int32validator.Between(0, 2),
 				},
 			},
+			"snapshot_identifier": schema.StringAttribute{
+				Description: "The identifier of a graph snapshot to restore the graph from.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRelative().AtParent().AtName("kms_key_identifier"),
+						path.MatchRelative().AtParent().AtName("vector_search_configuration"),
+					),
+				},
+			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 		},
@@ -203,16 +216,42 @@ func (r *graphResource) Create(ctx context.Context, request resource.CreateReque
 	)
 	input.Tags = getTagsIn(ctx)
 
-	output, err := conn.CreateGraph(ctx, &input)
+	var id *string
+	if snapshotID := data.SnapshotIdentifier.ValueString(); snapshotID != "" {
+		// The KMS key and vector search configuration are inherited from the snapshot.
+		input := neptunegraph.RestoreGraphFromSnapshotInput{
+			DeletionProtection: input.DeletionProtection,
+			GraphName:          input.GraphName,
+			ProvisionedMemory:  input.ProvisionedMemory,
+			PublicConnectivity: input.PublicConnectivity,
+			ReplicaCount:       input.ReplicaCount,
+			SnapshotIdentifier: aws.String(snapshotID),
+			Tags:               input.Tags,
+		}
 
-	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating Neptune Graph Graph (%s)", aws.ToString(input.GraphName)), err.Error())
+		output, err := conn.RestoreGraphFromSnapshot(ctx, &input)
 
-		return
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("restoring Neptune Graph Graph (%s) from snapshot (%s)", aws.ToString(input.GraphName), snapshotID), err.Error())
+
+			return
+		}
+
+		id = output.Id
+	} else {
+		output, err := conn.CreateGraph(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("creating Neptune Graph Graph (%s)", aws.ToString(input.GraphName)), err.Error())
+
+			return
+		}
+
+		id = output.Id
 	}
 
 	// Set values for unknowns.
-	data.ID = fwflex.StringToFramework(ctx, output.Id)
+	data.ID = fwflex.StringToFramework(ctx, id)
 
 	graph, err := waitGraphCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
 
@@ -228,6 +267,7 @@ func (r *graphResource) Create(ctx context.Context, request resource.CreateReque
 	if response.Diagnostics.HasError() {
 		return
 	}
+	clearInheritedGraphSettings(ctx, &data)
 
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
@@ -261,6 +301,7 @@ func (r *graphResource) Read(ctx context.Context, request resource.ReadRequest,
 	if response.Diagnostics.HasError() {
 		return
 	}
+	clearInheritedGraphSettings(ctx, &data)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -346,6 +387,14 @@ func (r *graphResource) Delete(ctx context.Context, request resource.DeleteReque
 	}
 }
 
+// clearInheritedGraphSettings drops the settings a restored graph inherits from its snapshot.
+// They can't be configured alongside snapshot_identifier, so storing them would cause a diff.
+func clearInheritedGraphSettings(ctx context.Context, data *graphResourceModel) {
+	if !data.SnapshotIdentifier.IsNull() {
+		data.VectorSearchConfiguration = fwtypes.NewListNestedObjectValueOfNull[vectorSearchConfigurationModel](ctx)
+	}
+}
+
 func findGraphByID(ctx context.Context, conn *neptunegraph.Client, id string) (*neptunegraph.GetGraphOutput, error) {
 	input := neptunegraph.GetGraphInput{
 		GraphIdentifier: aws.String(id),
@@ -392,7 +441,7 @@ func statusGraph(conn *neptunegraph.Client, id string) retry.StateRefreshFunc {
 
 func waitGraphCreated(ctx context.Context, conn *neptunegraph.Client, id string, timeout time.Duration) (*neptunegraph.GetGraphOutput, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:                   enum.Slice(awstypes.GraphStatusCreating),
+		Pending:                   enum.Slice(awstypes.GraphStatusCreating, awstypes.GraphStatusImporting),
 		Target:                    enum.Slice(awstypes.GraphStatusAvailable),
 		Refresh:                   statusGraph(conn, id),
 		Timeout:                   timeout,
@@ -465,6 +514,7 @@ type graphResourceModel struct {
 	ProvisionedMemory         types.Int32                                                     `tfsdk:"provisioned_memory"`
 	PublicConnectivity        types.Bool                                                      `tfsdk:"public_connectivity"`
 	ReplicaCount              types.Int32                                                     `tfsdk:"replica_count"`
+	SnapshotIdentifier        types.String                                                    `tfsdk:"snapshot_identifier"`
 	Tags                      tftags.Map                                                      `tfsdk:"tags"`
 	TagsAll                   tftags.Map                                                      `tfsdk:"tags_all"`
 	Timeouts                  timeouts.Value                                                  `tfsdk:"timeouts"`
@@ -317,6 +317,56 @@ func TestAccNeptuneGraphGraph_tags(t *testing.T) {
 	})
 }
 
+func TestAccNeptuneGraphGraph_snapshotIdentifier(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var graph neptunegraph.GetGraphOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_neptunegraph_graph.restored"
+	snapshotResourceName := "aws_neptunegraph_graph_snapshot.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NeptuneGraphServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGraphConfig_snapshotIdentifierVectorSearch(rName),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+				PlanOnly:    true,
+			},
+			{
+				Config: testAccGraphConfig_snapshotIdentifier(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGraphExists(ctx, t, resourceName, &graph),
+					resource.TestCheckResourceAttr(resourceName, "graph_name", rName+"-restored"),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_memory", "16"),
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot_identifier", snapshotResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "vector_search_configuration.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"graph_name_prefix", "snapshot_identifier", "vector_search_configuration"},
+			},
+		},
+	})
+}
+
 func testAccCheckGraphDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.ProviderMeta(ctx, t).NeptuneGraphClient(ctx)
@@ -576,3 +626,36 @@ resource "aws_neptunegraph_graph" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccGraphConfig_snapshotIdentifier(rName string) string {
+	return acctest.ConfigCompose(testAccGraphConfig_vectorSearch(rName, 128), fmt.Sprintf(`
+resource "aws_neptunegraph_graph_snapshot" "test" {
+  graph_identifier = aws_neptunegraph_graph.test.id
+  snapshot_name    = %[1]q
+}
+
+resource "aws_neptunegraph_graph" "restored" {
+  graph_name          = "%[1]s-restored"
+  provisioned_memory  = 16
+  public_connectivity = false
+  replica_count       = 0
+  deletion_protection = false
+  snapshot_identifier = aws_neptunegraph_graph_snapshot.test.id
+}
+`, rName))
+}
+
+func testAccGraphConfig_snapshotIdentifierVectorSearch(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_neptunegraph_graph" "restored" {
+  graph_name          = "%[1]s-restored"
+  provisioned_memory  = 16
+  deletion_protection = false
+  snapshot_identifier = "%[1]s"
+
+  vector_search_configuration {
+    vector_search_dimension = 128
+  }
+}
+`, rName)
+}
@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/neptunegraph/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	importTaskPollInterval     = 30 * time.Second
+	importTaskProgressInterval = 2 * time.Minute
+)
+
+// @Action(aws_neptunegraph_start_import_task, name="Start Import Task")
+func newStartImportTaskAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startImportTaskAction{}, nil
+}
+
+var (
+	_ action.Action = (*startImportTaskAction)(nil)
+)
+
+type startImportTaskAction struct {
+	framework.ActionWithModel[startImportTaskActionModel]
+}
+
+type startImportTaskActionModel struct {
+	framework.WithRegionModel
+	BlankNodeHandling fwtypes.StringEnum[awstypes.BlankNodeHandling] `tfsdk:"blank_node_handling"`
+	FailOnError       types.Bool                                     `tfsdk:"fail_on_error"`
+	Format            fwtypes.StringEnum[awstypes.Format]            `tfsdk:"format"`
+	GraphIdentifier   types.String                                   `tfsdk:"graph_identifier"`
+	ParquetType       fwtypes.StringEnum[awstypes.ParquetType]       `tfsdk:"parquet_type"`
+	RoleARN           fwtypes.ARN                                    `tfsdk:"role_arn"`
+	Source            types.String                                   `tfsdk:"source"`
+	Timeout           types.Int64                                    `tfsdk:"timeout"`
+}
+
+func (a *startImportTaskAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a Neptune Analytics import task that loads data from Amazon S3 into an existing graph and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"blank_node_handling": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.BlankNodeHandling](),
+				Description: "The method to handle blank nodes in the dataset.",
+				Optional:    true,
+			},
+			"fail_on_error": schema.BoolAttribute{
+				Description: "Whether the import task stops on the first error it encounters.",
+				Optional:    true,
+			},
+			names.AttrFormat: schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.Format](),
+				Description: "The format of the data being imported.",
+				Optional:    true,
+			},
+			"graph_identifier": schema.StringAttribute{
+				Description: "The unique identifier of the Neptune Analytics graph to load data into.",
+				Required:    true,
+			},
+			"parquet_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.ParquetType](),
+				Description: "The parquet type of the data being imported.",
+				Optional:    true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the IAM role that allows access to the data to be imported.",
+				Required:    true,
+			},
+			names.AttrSource: schema.StringAttribute{
+				Description: "The S3 URI of the data to be imported.",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Maximum time in seconds to wait for the import task to complete. Defaults to 3600 seconds (60 minutes).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(60, 86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *startImportTaskAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startImportTaskActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().NeptuneGraphClient(ctx)
+
+	graphID := config.GraphIdentifier.ValueString()
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting import task action", map[string]any{
+		"graph_identifier": graphID,
+		"source":           config.Source.ValueString(),
+		"timeout_seconds":  int64(timeout.Seconds()),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting import task for Neptune Analytics graph %s...", graphID),
+	})
+
+	var input neptunegraph.StartImportTaskInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.StartImportTask(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Import Task",
+			fmt.Sprintf("Could not start import task for Neptune Analytics graph %s: %s", graphID, err),
+		)
+		return
+	}
+
+	taskID := aws.ToString(output.TaskId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Import task %s started, waiting for completion...", taskID),
+	})
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*neptunegraph.GetImportTaskOutput], error) {
+		input := neptunegraph.GetImportTaskInput{
+			TaskIdentifier: aws.String(taskID),
+		}
+		output, err := conn.GetImportTask(ctx, &input)
+		if err != nil {
+			return actionwait.FetchResult[*neptunegraph.GetImportTaskOutput]{}, fmt.Errorf("get import task: %w", err)
+		}
+		return actionwait.FetchResult[*neptunegraph.GetImportTaskOutput]{Status: actionwait.Status(output.Status), Value: output}, nil
+	}, actionwait.Options[*neptunegraph.GetImportTaskOutput]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(importTaskPollInterval),
+		ProgressInterval: importTaskProgressInterval,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.ImportTaskStatusSucceeded),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.ImportTaskStatusInitializing),
+			actionwait.Status(awstypes.ImportTaskStatusExporting),
+			actionwait.Status(awstypes.ImportTaskStatusAnalyzingData),
+			actionwait.Status(awstypes.ImportTaskStatusImporting),
+			actionwait.Status(awstypes.ImportTaskStatusReprovisioning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.ImportTaskStatusRollingBack),
+			actionwait.Status(awstypes.ImportTaskStatusFailed),
+			actionwait.Status(awstypes.ImportTaskStatusCancelling),
+			actionwait.Status(awstypes.ImportTaskStatusCancelled),
+			actionwait.Status(awstypes.ImportTaskStatusDeleted),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			message := fmt.Sprintf("Import task %s is currently %s", taskID, fr.Status)
+			if v, ok := fr.Value.(*neptunegraph.GetImportTaskOutput); ok && v != nil && v.ImportTaskDetails != nil {
+				details := v.ImportTaskDetails
+				message = fmt.Sprintf("%s (%d%% complete, %d statements loaded, %d errors)", message, aws.ToInt32(details.ProgressPercentage), aws.ToInt64(details.StatementCount), aws.ToInt32(details.ErrorCount))
+			}
+			resp.SendProgress(action.InvokeProgressEvent{Message: message})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Import Task",
+				fmt.Sprintf("Import task %s did not complete within %v", taskID, timeout),
+			)
+		} else if errors.As(err, &failureErr) {
+			message := ""
+			if fr.Value != nil {
+				message = aws.ToString(fr.Value.StatusReason)
+			}
+			resp.Diagnostics.AddError(
+				"Import Task Failed",
+				fmt.Sprintf("Import task %s failed with status %s: %s", taskID, failureErr.Status, message),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Task Status",
+				fmt.Sprintf("Import task %s entered unexpected status: %s", taskID, unexpectedErr.Status),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Import Task",
+				fmt.Sprintf("Error while waiting for import task %s: %s", taskID, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Import task %s finished with status %s", taskID, fr.Status)})
+	tflog.Info(ctx, "Import task completed", map[string]any{
+		"graph_identifier":   graphID,
+		"import_task_id":     taskID,
+		"import_task_status": fr.Status,
+	})
+}
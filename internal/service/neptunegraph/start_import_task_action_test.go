@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNeptuneGraphStartImportTaskAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_neptunegraph_graph.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck: acctest.ErrorCheck(t, names.NeptuneGraphServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartImportTaskActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImportTaskStatus(ctx, t, resourceName, types.ImportTaskStatusSucceeded),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImportTaskStatus(ctx context.Context, t *testing.T, n string, expected types.ImportTaskStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).NeptuneGraphClient(ctx)
+
+		var input neptunegraph.ListImportTasksInput
+		pages := neptunegraph.NewListImportTasksPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return fmt.Errorf("listing Neptune Graph import tasks: %w", err)
+			}
+
+			for _, v := range page.Tasks {
+				if aws.ToString(v.GraphId) != rs.Primary.ID {
+					continue
+				}
+
+				if v.Status != expected {
+					return fmt.Errorf("expected Neptune Graph Import Task %s status to be %s, got %s", aws.ToString(v.TaskId), expected, v.Status)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Neptune Graph Import Task not found for graph %s", rs.Primary.ID)
+	}
+}
+
+func testAccStartImportTaskActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGraphConfig_basic(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "data/nodes.csv"
+  content = <<EOT
+~id,~label,name:String
+1,person,Alice
+2,person,Bob
+EOT
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "neptune-graph.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:GetObject", "s3:ListBucket"]
+      Effect   = "Allow"
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+action "aws_neptunegraph_start_import_task" "test" {
+  config {
+    graph_identifier = aws_neptunegraph_graph.test.id
+    role_arn         = aws_iam_role.test.arn
+    source           = "s3://${aws_s3_bucket.test.bucket}/data/"
+    format           = "CSV"
+    fail_on_error    = true
+    timeout          = 3600
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_s3_object.test.etag
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_neptunegraph_start_import_task.test]
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -18,7 +19,9 @@ import (
 )
 
 func RegisterSweepers() {
-	awsv2.Register("aws_neptunegraph_graph", sweepGraphs)
+	awsv2.Register("aws_neptunegraph_graph", sweepGraphs, "aws_neptunegraph_private_graph_endpoint")
+	awsv2.Register("aws_neptunegraph_graph_snapshot", sweepGraphSnapshots)
+	awsv2.Register("aws_neptunegraph_private_graph_endpoint", sweepPrivateGraphEndpoints)
 }
 
 func sweepGraphs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
@@ -62,3 +65,72 @@ func sweepGraphs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepabl
 
 	return sweepResources, nil
 }
+
+func sweepGraphSnapshots(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	var input neptunegraph.ListGraphSnapshotsInput
+	conn := client.NeptuneGraphClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := neptunegraph.NewListGraphSnapshotsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.GraphSnapshots {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newGraphSnapshotResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Id))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepPrivateGraphEndpoints(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	var input neptunegraph.ListGraphsInput
+	conn := client.NeptuneGraphClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := neptunegraph.NewListGraphsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Graphs {
+			graphID := aws.ToString(v.Id)
+			input := neptunegraph.ListPrivateGraphEndpointsInput{
+				GraphIdentifier: aws.String(graphID),
+			}
+
+			pages := neptunegraph.NewListPrivateGraphEndpointsPaginator(conn, &input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.PrivateGraphEndpoints {
+					vpcID := aws.ToString(v.VpcId)
+					id, err := flex.FlattenResourceId([]string{graphID, vpcID}, privateGraphEndpointResourceIDPartCount, false)
+
+					if err != nil {
+						return nil, err
+					}
+
+					sweepResources = append(sweepResources, framework.NewSweepResource(newPrivateGraphEndpointResource, client,
+						framework.NewAttribute(names.AttrID, id),
+						framework.NewAttribute("graph_identifier", graphID),
+						framework.NewAttribute(names.AttrVPCID, vpcID)))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -5,7 +5,11 @@ package osis
 
 // Exports for use in tests only.
 var (
-	ResourcePipeline = newPipelineResource
+	ResourcePipeline         = newPipelineResource
+	ResourcePipelineEndpoint = newPipelineEndpointResource
+	ResourceResourcePolicy   = newResourcePolicyResource
 
-	FindPipelineByName = findPipelineByName
+	FindPipelineByName       = findPipelineByName
+	FindPipelineEndpointByID = findPipelineEndpointByID
+	FindResourcePolicyByARN  = findResourcePolicyByARN
 )
@@ -83,7 +83,8 @@ func (r *pipelineResource) Schema(ctx context.Context, request resource.SchemaRe
 			},
 			"pipeline_arn": framework.ARNAttributeComputedOnly(),
 			"pipeline_configuration_body": schema.StringAttribute{
-				Required: true,
+				CustomType: fwtypes.JSONOrYAMLStringType,
+				Required:   true,
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 24000),
 				},
@@ -501,7 +502,7 @@ type pipelineResourceModel struct {
 	MaxUnits                  types.Int64                                                   `tfsdk:"max_units"`
 	MinUnits                  types.Int64                                                   `tfsdk:"min_units"`
 	PipelineARN               types.String                                                  `tfsdk:"pipeline_arn"`
-	PipelineConfigurationBody types.String                                                  `tfsdk:"pipeline_configuration_body"`
+	PipelineConfigurationBody fwtypes.JSONOrYAMLString                                      `tfsdk:"pipeline_configuration_body"`
 	PipelineName              types.String                                                  `tfsdk:"pipeline_name"`
 	PipelineRoleARN           fwtypes.ARN                                                   `tfsdk:"pipeline_role_arn"`
 	Tags                      tftags.Map                                                    `tfsdk:"tags"`
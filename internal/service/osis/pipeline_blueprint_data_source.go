@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package osis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/osis"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/osis/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_osis_pipeline_blueprint", name="Pipeline Blueprint")
+func newPipelineBlueprintDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &pipelineBlueprintDataSource{}, nil
+}
+
+type pipelineBlueprintDataSource struct {
+	framework.DataSourceWithModel[pipelineBlueprintDataSourceModel]
+}
+
+func (d *pipelineBlueprintDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"blueprint_name": schema.StringAttribute{
+				Required: true,
+			},
+			"display_description": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Computed: true,
+			},
+			"pipeline_configuration_body": schema.StringAttribute{
+				CustomType: fwtypes.JSONOrYAMLStringType,
+				Computed:   true,
+			},
+			"service": schema.StringAttribute{
+				Computed: true,
+			},
+			"use_case": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *pipelineBlueprintDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data pipelineBlueprintDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().OpenSearchIngestionClient(ctx)
+
+	name := data.BlueprintName.ValueString()
+	blueprint, err := findPipelineBlueprintByName(ctx, conn, name, pipelineBlueprintFormatYAML)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading OpenSearch Ingestion Pipeline Blueprint (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, blueprint, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findPipelineBlueprintByName(ctx context.Context, conn *osis.Client, name, format string) (*awstypes.PipelineBlueprint, error) {
+	input := &osis.GetPipelineBlueprintInput{
+		BlueprintName: aws.String(name),
+		Format:        aws.String(format),
+	}
+
+	output, err := conn.GetPipelineBlueprint(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &sdkretry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Blueprint == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Blueprint, nil
+}
+
+type pipelineBlueprintDataSourceModel struct {
+	framework.WithRegionModel
+	BlueprintName             types.String             `tfsdk:"blueprint_name"`
+	DisplayDescription        types.String             `tfsdk:"display_description"`
+	DisplayName               types.String             `tfsdk:"display_name"`
+	PipelineConfigurationBody fwtypes.JSONOrYAMLString `tfsdk:"pipeline_configuration_body"`
+	Service                   types.String             `tfsdk:"service"`
+	UseCase                   types.String             `tfsdk:"use_case"`
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package osis_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOpenSearchIngestionPipelineBlueprintDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_osis_pipeline_blueprint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchIngestionEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchIngestionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineBlueprintDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "blueprint_name", "AWS-LogAggregationWithConditionalRouting"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrDisplayName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "pipeline_configuration_body"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPipelineBlueprintDataSourceConfig_basic = `
+data "aws_osis_pipeline_blueprint" "test" {
+  blueprint_name = "AWS-LogAggregationWithConditionalRouting"
+}
+`
@@ -0,0 +1,352 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package osis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/osis"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/osis/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_osis_pipeline_endpoint", name="Pipeline Endpoint")
+func newPipelineEndpointResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &pipelineEndpointResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type pipelineEndpointResource struct {
+	framework.ResourceWithModel[pipelineEndpointResourceModel]
+	framework.WithImportByID
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (r *pipelineEndpointResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"ingest_endpoint_url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pipeline_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrVPCID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+			"vpc_options": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[pipelineEndpointVPCOptionsModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							Optional:    true,
+							ElementType: types.StringType,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(1, 12),
+							},
+						},
+						names.AttrSubnetIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							Required:    true,
+							ElementType: types.StringType,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(1, 12),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *pipelineEndpointResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data pipelineEndpointResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchIngestionClient(ctx)
+
+	pipelineARN := data.PipelineARN.ValueString()
+	var input osis.CreatePipelineEndpointInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreatePipelineEndpoint(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating OpenSearch Ingestion Pipeline Endpoint (%s)", pipelineARN), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	id := aws.ToString(output.EndpointId)
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	endpoint, err := waitPipelineEndpointCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), id)...)
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for OpenSearch Ingestion Pipeline Endpoint (%s) create", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.IngestEndpointURL = fwflex.StringToFramework(ctx, endpoint.IngestEndpointUrl)
+	data.VPCID = fwflex.StringToFramework(ctx, endpoint.VpcId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *pipelineEndpointResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data pipelineEndpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchIngestionClient(ctx)
+
+	id := data.ID.ValueString()
+	endpoint, err := findPipelineEndpointByID(ctx, conn, id)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading OpenSearch Ingestion Pipeline Endpoint (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, endpoint, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *pipelineEndpointResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data pipelineEndpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchIngestionClient(ctx)
+
+	id := data.ID.ValueString()
+	input := osis.DeletePipelineEndpointInput{
+		EndpointId: aws.String(id),
+	}
+	_, err := conn.DeletePipelineEndpoint(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting OpenSearch Ingestion Pipeline Endpoint (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitPipelineEndpointDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for OpenSearch Ingestion Pipeline Endpoint (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func findPipelineEndpointByID(ctx context.Context, conn *osis.Client, id string) (*awstypes.PipelineEndpoint, error) {
+	var input osis.ListPipelineEndpointsInput
+	output, err := findPipelineEndpoint(ctx, conn, &input, func(v *awstypes.PipelineEndpoint) bool {
+		return aws.ToString(v.EndpointId) == id
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.Status; status == awstypes.PipelineEndpointStatusRevoked {
+		return nil, &sdkretry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findPipelineEndpoint(ctx context.Context, conn *osis.Client, input *osis.ListPipelineEndpointsInput, filter tfslices.Predicate[*awstypes.PipelineEndpoint]) (*awstypes.PipelineEndpoint, error) {
+	output, err := findPipelineEndpoints(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findPipelineEndpoints(ctx context.Context, conn *osis.Client, input *osis.ListPipelineEndpointsInput, filter tfslices.Predicate[*awstypes.PipelineEndpoint]) ([]awstypes.PipelineEndpoint, error) {
+	var output []awstypes.PipelineEndpoint
+
+	pages := osis.NewListPipelineEndpointsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.PipelineEndpoints {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func statusPipelineEndpoint(ctx context.Context, conn *osis.Client, id string) sdkretry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findPipelineEndpointByID(ctx, conn, id)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitPipelineEndpointCreated(ctx context.Context, conn *osis.Client, id string, timeout time.Duration) (*awstypes.PipelineEndpoint, error) {
+	stateConf := &sdkretry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.PipelineEndpointStatusCreating),
+		Target:     enum.Slice(awstypes.PipelineEndpointStatusActive),
+		Refresh:    statusPipelineEndpoint(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.PipelineEndpoint); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPipelineEndpointDeleted(ctx context.Context, conn *osis.Client, id string, timeout time.Duration) (*awstypes.PipelineEndpoint, error) {
+	stateConf := &sdkretry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.PipelineEndpointStatusActive, awstypes.PipelineEndpointStatusDeleting, awstypes.PipelineEndpointStatusRevoking),
+		Target:     []string{},
+		Refresh:    statusPipelineEndpoint(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.PipelineEndpoint); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type pipelineEndpointResourceModel struct {
+	framework.WithRegionModel
+	ID                types.String                                                     `tfsdk:"id"`
+	IngestEndpointURL types.String                                                     `tfsdk:"ingest_endpoint_url"`
+	PipelineARN       fwtypes.ARN                                                      `tfsdk:"pipeline_arn"`
+	Timeouts          timeouts.Value                                                   `tfsdk:"timeouts"`
+	VPCID             types.String                                                     `tfsdk:"vpc_id"`
+	VPCOptions        fwtypes.ListNestedObjectValueOf[pipelineEndpointVPCOptionsModel] `tfsdk:"vpc_options"`
+}
+
+type pipelineEndpointVPCOptionsModel struct {
+	SecurityGroupIDs fwtypes.SetValueOf[types.String] `tfsdk:"security_group_ids"`
+	SubnetIDs        fwtypes.SetValueOf[types.String] `tfsdk:"subnet_ids"`
+}
@@ -16,7 +16,8 @@ import (
 )
 
 func RegisterSweepers() {
-	awsv2.Register("aws_osis_pipeline", sweepPipelines)
+	awsv2.Register("aws_osis_pipeline", sweepPipelines, "aws_osis_pipeline_endpoint")
+	awsv2.Register("aws_osis_pipeline_endpoint", sweepPipelineEndpoints)
 }
 
 func sweepPipelines(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
@@ -42,3 +43,25 @@ func sweepPipelines(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweep
 
 	return sweepResources, nil
 }
+
+func sweepPipelineEndpoints(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.OpenSearchIngestionClient(ctx)
+	var input osis.ListPipelineEndpointsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := osis.NewListPipelineEndpointsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.PipelineEndpoints {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newPipelineEndpointResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.EndpointId))))
+		}
+	}
+
+	return sweepResources, nil
+}
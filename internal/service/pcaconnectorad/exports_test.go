@@ -0,0 +1,17 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+// Exports for use in tests only.
+var (
+	ResourceConnector                       = newConnectorResource
+	ResourceDirectoryRegistration           = newDirectoryRegistrationResource
+	ResourceTemplate                        = newTemplateResource
+	ResourceTemplateGroupAccessControlEntry = newTemplateGroupAccessControlEntryResource
+
+	FindConnectorByARN                              = findConnectorByARN
+	FindDirectoryRegistrationByARN                  = findDirectoryRegistrationByARN
+	FindTemplateByARN                               = findTemplateByARN
+	FindTemplateGroupAccessControlEntryByTwoPartKey = findTemplateGroupAccessControlEntryByTwoPartKey
+)
@@ -0,0 +1,1057 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcaconnectorad_template", name="Template")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &templateResource{}
+
+	r.SetDefaultDeleteTimeout(15 * time.Minute)
+
+	return r, nil
+}
+
+type templateResource struct {
+	framework.ResourceWithModel[templateResourceModel]
+	framework.WithImportByARN
+	framework.WithTimeouts
+}
+
+func (r *templateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	optionalBoolAttribute := func() schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		}
+	}
+	validityPeriodBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[validityPeriodModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"period": schema.Int64Attribute{
+						Required: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"period_type": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.ValidityPeriodType](),
+						Required:   true,
+					},
+				},
+			},
+		}
+	}
+	certificateValidityBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[certificateValidityModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Blocks: map[string]schema.Block{
+					"renewal_period":  validityPeriodBlock(),
+					"validity_period": validityPeriodBlock(),
+				},
+			},
+		}
+	}
+	enrollmentFlagsBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[enrollmentFlagsModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"enable_key_reuse_on_nt_token_keyset_storage_full": optionalBoolAttribute(),
+					"include_symmetric_algorithms":                     optionalBoolAttribute(),
+					"no_security_extension":                            optionalBoolAttribute(),
+					"remove_invalid_certificate_from_personal_store":   optionalBoolAttribute(),
+					"user_interaction_required":                        optionalBoolAttribute(),
+				},
+			},
+		}
+	}
+	extensionsBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[extensionsModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Blocks: map[string]schema.Block{
+					"application_policies": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[applicationPoliciesModel](ctx),
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"critical": optionalBoolAttribute(),
+							},
+							Blocks: map[string]schema.Block{
+								"policy": schema.ListNestedBlock{
+									CustomType: fwtypes.NewListNestedObjectTypeOf[applicationPolicyModel](ctx),
+									Validators: []validator.List{
+										listvalidator.IsRequired(),
+										listvalidator.SizeBetween(1, 100),
+									},
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											"policy_object_identifier": schema.StringAttribute{
+												Optional: true,
+												Validators: []validator.String{
+													stringvalidator.LengthBetween(1, 64),
+													stringvalidator.ExactlyOneOf(
+														path.MatchRelative().AtParent().AtName("policy_object_identifier"),
+														path.MatchRelative().AtParent().AtName("policy_type"),
+													),
+												},
+											},
+											"policy_type": schema.StringAttribute{
+												CustomType: fwtypes.StringEnumType[awstypes.ApplicationPolicyType](),
+												Optional:   true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"key_usage": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsageModel](ctx),
+						Validators: []validator.List{
+							listvalidator.IsRequired(),
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"critical": optionalBoolAttribute(),
+							},
+							Blocks: map[string]schema.Block{
+								"usage_flags": schema.ListNestedBlock{
+									CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsageFlagsModel](ctx),
+									Validators: []validator.List{
+										listvalidator.IsRequired(),
+										listvalidator.SizeAtMost(1),
+									},
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											"data_encipherment": optionalBoolAttribute(),
+											"digital_signature": optionalBoolAttribute(),
+											"key_agreement":     optionalBoolAttribute(),
+											"key_encipherment":  optionalBoolAttribute(),
+											"non_repudiation":   optionalBoolAttribute(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	generalFlagsBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[generalFlagsModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"auto_enrollment": optionalBoolAttribute(),
+					"machine_type":    optionalBoolAttribute(),
+				},
+			},
+		}
+	}
+	privateKeyAttributesBlock := func(withKeyUsageProperty, algorithmRequired bool) schema.ListNestedBlock {
+		attributes := map[string]schema.Attribute{
+			"crypto_providers": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"key_spec": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.KeySpec](),
+				Required:   true,
+			},
+			"minimal_key_length": schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+		}
+		blocks := map[string]schema.Block{}
+		if withKeyUsageProperty {
+			attributes["algorithm"] = schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PrivateKeyAlgorithm](),
+				Required:   algorithmRequired,
+				Optional:   !algorithmRequired,
+			}
+			keyUsagePropertyValidators := []validator.List{
+				listvalidator.SizeAtMost(1),
+			}
+			if algorithmRequired {
+				keyUsagePropertyValidators = append(keyUsagePropertyValidators, listvalidator.IsRequired())
+			}
+			blocks["key_usage_property"] = schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsagePropertyModel](ctx),
+				Validators: keyUsagePropertyValidators,
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"property_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.KeyUsagePropertyType](),
+							Optional:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"property_flags": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsagePropertyFlagsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("property_flags"),
+									path.MatchRelative().AtParent().AtName("property_type"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"decrypt":       optionalBoolAttribute(),
+									"key_agreement": optionalBoolAttribute(),
+									"sign":          optionalBoolAttribute(),
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		block := schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesV2Model](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: attributes,
+				Blocks:     blocks,
+			},
+		}
+		if withKeyUsageProperty {
+			block.CustomType = fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesModel](ctx)
+		}
+
+		return block
+	}
+	subjectNameFlagsBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[subjectNameFlagsModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"require_common_name":        optionalBoolAttribute(),
+					"require_directory_path":     optionalBoolAttribute(),
+					"require_dns_as_cn":          optionalBoolAttribute(),
+					"require_email":              optionalBoolAttribute(),
+					"san_require_directory_guid": optionalBoolAttribute(),
+					"san_require_dns":            optionalBoolAttribute(),
+					"san_require_domain_dns":     optionalBoolAttribute(),
+					"san_require_email":          optionalBoolAttribute(),
+					"san_require_spn":            optionalBoolAttribute(),
+					"san_require_upn":            optionalBoolAttribute(),
+				},
+			},
+		}
+	}
+	supersededTemplatesAttribute := func() schema.SetAttribute {
+		return schema.SetAttribute{
+			CustomType:  fwtypes.SetOfStringType,
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtMost(100),
+			},
+		}
+	}
+	templateVersionPaths := []path.Expression{
+		path.MatchRelative().AtParent().AtName("template_v2"),
+		path.MatchRelative().AtParent().AtName("template_v3"),
+		path.MatchRelative().AtParent().AtName("template_v4"),
+	}
+	templateVersionValidators := []validator.List{
+		listvalidator.SizeAtMost(1),
+		listvalidator.ExactlyOneOf(templateVersionPaths...),
+	}
+	templateVersionPlanModifiers := []planmodifier.List{
+		requiresReplaceOnTemplateVersionChange(),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"connector_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"object_identifier": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reenroll_all_certificate_holders": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[templateDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"template_v2": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[templateV2Model](ctx),
+							Validators:    templateVersionValidators,
+							PlanModifiers: templateVersionPlanModifiers,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"superseded_templates": supersededTemplatesAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"certificate_validity":   certificateValidityBlock(),
+									"enrollment_flags":       enrollmentFlagsBlock(),
+									"extensions":             extensionsBlock(),
+									"general_flags":          generalFlagsBlock(),
+									"private_key_attributes": privateKeyAttributesBlock(false, false),
+									"private_key_flags": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyFlagsV2Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"client_version": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ClientCompatibilityV2](),
+													Required:   true,
+												},
+												"exportable_key":                 optionalBoolAttribute(),
+												"strong_key_protection_required": optionalBoolAttribute(),
+											},
+										},
+									},
+									"subject_name_flags": subjectNameFlagsBlock(),
+								},
+							},
+						},
+						"template_v3": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[templateV3Model](ctx),
+							Validators:    templateVersionValidators,
+							PlanModifiers: templateVersionPlanModifiers,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"hash_algorithm": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.HashAlgorithm](),
+										Required:   true,
+									},
+									"superseded_templates": supersededTemplatesAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"certificate_validity":   certificateValidityBlock(),
+									"enrollment_flags":       enrollmentFlagsBlock(),
+									"extensions":             extensionsBlock(),
+									"general_flags":          generalFlagsBlock(),
+									"private_key_attributes": privateKeyAttributesBlock(true, true),
+									"private_key_flags": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyFlagsV3Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"client_version": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ClientCompatibilityV3](),
+													Required:   true,
+												},
+												"exportable_key":                        optionalBoolAttribute(),
+												"require_alternate_signature_algorithm": optionalBoolAttribute(),
+												"strong_key_protection_required":        optionalBoolAttribute(),
+											},
+										},
+									},
+									"subject_name_flags": subjectNameFlagsBlock(),
+								},
+							},
+						},
+						"template_v4": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[templateV4Model](ctx),
+							Validators:    templateVersionValidators,
+							PlanModifiers: templateVersionPlanModifiers,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"hash_algorithm": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.HashAlgorithm](),
+										Optional:   true,
+									},
+									"superseded_templates": supersededTemplatesAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"certificate_validity":   certificateValidityBlock(),
+									"enrollment_flags":       enrollmentFlagsBlock(),
+									"extensions":             extensionsBlock(),
+									"general_flags":          generalFlagsBlock(),
+									"private_key_attributes": privateKeyAttributesBlock(true, false),
+									"private_key_flags": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyFlagsV4Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"client_version": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ClientCompatibilityV4](),
+													Required:   true,
+												},
+												"exportable_key":                        optionalBoolAttribute(),
+												"require_alternate_signature_algorithm": optionalBoolAttribute(),
+												"require_same_key_renewal":              optionalBoolAttribute(),
+												"strong_key_protection_required":        optionalBoolAttribute(),
+												"use_legacy_provider":                   optionalBoolAttribute(),
+											},
+										},
+									},
+									"subject_name_flags": subjectNameFlagsBlock(),
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// requiresReplaceOnTemplateVersionChange forces replacement when the configured template
+// definition version changes. Templates cannot change schema version in place.
+func requiresReplaceOnTemplateVersionChange() planmodifier.List {
+	return listplanmodifier.RequiresReplaceIf(func(ctx context.Context, request planmodifier.ListRequest, response *listplanmodifier.RequiresReplaceIfFuncResponse) {
+		response.RequiresReplace = request.StateValue.IsNull() != request.PlanValue.IsNull()
+	}, "Replacement is required when the template version changes.", "Replacement is required when the template version changes.")
+}
+
+func (r *templateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data templateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	name := data.Name.ValueString()
+	var input pcaconnectorad.CreateTemplateInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateTemplate(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	arn := aws.ToString(output.TemplateArn)
+	template, err := findTemplateByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint.
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringValueToFramework(ctx, arn)
+	data.ObjectIdentifier = fwflex.StringToFramework(ctx, template.ObjectIdentifier)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *templateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	arn := data.ARN.ValueString()
+	output, err := findTemplateByARN(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *templateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old templateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	if !new.Definition.Equal(old.Definition) || !new.ReenrollAllCertificateHolders.Equal(old.ReenrollAllCertificateHolders) {
+		arn := new.ARN.ValueString()
+		var input pcaconnectorad.UpdateTemplateInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.TemplateArn = aws.String(arn)
+
+		_, err := conn.UpdateTemplate(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *templateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	arn := data.ARN.ValueString()
+	input := pcaconnectorad.DeleteTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+	_, err := conn.DeleteTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	if _, err := waitTemplateDeleted(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+}
+
+func findTemplateByARN(ctx context.Context, conn *pcaconnectorad.Client, arn string) (*awstypes.Template, error) {
+	input := pcaconnectorad.GetTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Template == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	return output.Template, nil
+}
+
+func statusTemplate(conn *pcaconnectorad.Client, arn string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findTemplateByARN(ctx, conn, arn)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", smarterr.NewError(err)
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitTemplateDeleted(ctx context.Context, conn *pcaconnectorad.Client, arn string, timeout time.Duration) (*awstypes.Template, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.TemplateStatusDeleting, awstypes.TemplateStatusActive),
+		Target:  []string{},
+		Refresh: statusTemplate(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Template); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+type templateResourceModel struct {
+	framework.WithRegionModel
+	ARN                           types.String                                             `tfsdk:"arn"`
+	ConnectorARN                  fwtypes.ARN                                              `tfsdk:"connector_arn"`
+	Definition                    fwtypes.ListNestedObjectValueOf[templateDefinitionModel] `tfsdk:"definition"`
+	Name                          types.String                                             `tfsdk:"name"`
+	ObjectIdentifier              types.String                                             `tfsdk:"object_identifier"`
+	ReenrollAllCertificateHolders types.Bool                                               `tfsdk:"reenroll_all_certificate_holders"`
+	Tags                          tftags.Map                                               `tfsdk:"tags"`
+	TagsAll                       tftags.Map                                               `tfsdk:"tags_all"`
+	Timeouts                      timeouts.Value                                           `tfsdk:"timeouts"`
+}
+
+type templateDefinitionModel struct {
+	TemplateV2 fwtypes.ListNestedObjectValueOf[templateV2Model] `tfsdk:"template_v2"`
+	TemplateV3 fwtypes.ListNestedObjectValueOf[templateV3Model] `tfsdk:"template_v3"`
+	TemplateV4 fwtypes.ListNestedObjectValueOf[templateV4Model] `tfsdk:"template_v4"`
+}
+
+var (
+	_ fwflex.Expander  = templateDefinitionModel{}
+	_ fwflex.Flattener = &templateDefinitionModel{}
+)
+
+func (m templateDefinitionModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.TemplateV2.IsNull():
+		templateV2Data, d := m.TemplateV2.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.TemplateDefinitionMemberTemplateV2
+		diags.Append(fwflex.Expand(ctx, templateV2Data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.TemplateV3.IsNull():
+		templateV3Data, d := m.TemplateV3.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.TemplateDefinitionMemberTemplateV3
+		diags.Append(fwflex.Expand(ctx, templateV3Data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.TemplateV4.IsNull():
+		templateV4Data, d := m.TemplateV4.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.TemplateDefinitionMemberTemplateV4
+		diags.Append(fwflex.Expand(ctx, templateV4Data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *templateDefinitionModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.TemplateDefinitionMemberTemplateV2:
+		var model templateV2Model
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.TemplateV2 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.TemplateDefinitionMemberTemplateV3:
+		var model templateV3Model
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.TemplateV3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.TemplateDefinitionMemberTemplateV4:
+		var model templateV4Model
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.TemplateV4 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+type templateV2Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]    `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]        `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]             `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]           `tfsdk:"general_flags"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesV2Model] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV2Model]      `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]       `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.SetOfString                                          `tfsdk:"superseded_templates"`
+}
+
+type templateV3Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]  `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]      `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]           `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]         `tfsdk:"general_flags"`
+	HashAlgorithm        fwtypes.StringEnum[awstypes.HashAlgorithm]                 `tfsdk:"hash_algorithm"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesModel] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV3Model]    `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]     `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.SetOfString                                        `tfsdk:"superseded_templates"`
+}
+
+type templateV4Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]  `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]      `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]           `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]         `tfsdk:"general_flags"`
+	HashAlgorithm        fwtypes.StringEnum[awstypes.HashAlgorithm]                 `tfsdk:"hash_algorithm"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesModel] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV4Model]    `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]     `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.SetOfString                                        `tfsdk:"superseded_templates"`
+}
+
+type certificateValidityModel struct {
+	RenewalPeriod  fwtypes.ListNestedObjectValueOf[validityPeriodModel] `tfsdk:"renewal_period"`
+	ValidityPeriod fwtypes.ListNestedObjectValueOf[validityPeriodModel] `tfsdk:"validity_period"`
+}
+
+type validityPeriodModel struct {
+	Period     types.Int64                                     `tfsdk:"period"`
+	PeriodType fwtypes.StringEnum[awstypes.ValidityPeriodType] `tfsdk:"period_type"`
+}
+
+type enrollmentFlagsModel struct {
+	EnableKeyReuseOnNtTokenKeysetStorageFull  types.Bool `tfsdk:"enable_key_reuse_on_nt_token_keyset_storage_full"`
+	IncludeSymmetricAlgorithms                types.Bool `tfsdk:"include_symmetric_algorithms"`
+	NoSecurityExtension                       types.Bool `tfsdk:"no_security_extension"`
+	RemoveInvalidCertificateFromPersonalStore types.Bool `tfsdk:"remove_invalid_certificate_from_personal_store"`
+	UserInteractionRequired                   types.Bool `tfsdk:"user_interaction_required"`
+}
+
+type extensionsModel struct {
+	ApplicationPolicies fwtypes.ListNestedObjectValueOf[applicationPoliciesModel] `tfsdk:"application_policies"`
+	KeyUsage            fwtypes.ListNestedObjectValueOf[keyUsageModel]            `tfsdk:"key_usage"`
+}
+
+type applicationPoliciesModel struct {
+	Critical types.Bool                                              `tfsdk:"critical"`
+	Policies fwtypes.ListNestedObjectValueOf[applicationPolicyModel] `tfsdk:"policy"`
+}
+
+type applicationPolicyModel struct {
+	PolicyObjectIdentifier types.String                                       `tfsdk:"policy_object_identifier"`
+	PolicyType             fwtypes.StringEnum[awstypes.ApplicationPolicyType] `tfsdk:"policy_type"`
+}
+
+var (
+	_ fwflex.Expander  = applicationPolicyModel{}
+	_ fwflex.Flattener = &applicationPolicyModel{}
+)
+
+func (m applicationPolicyModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.PolicyObjectIdentifier.IsNull():
+		return &awstypes.ApplicationPolicyMemberPolicyObjectIdentifier{
+			Value: m.PolicyObjectIdentifier.ValueString(),
+		}, diags
+
+	case !m.PolicyType.IsNull():
+		return &awstypes.ApplicationPolicyMemberPolicyType{
+			Value: m.PolicyType.ValueEnum(),
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *applicationPolicyModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.PolicyObjectIdentifier = types.StringNull()
+	m.PolicyType = fwtypes.StringEnumNull[awstypes.ApplicationPolicyType]()
+
+	switch t := v.(type) {
+	case awstypes.ApplicationPolicyMemberPolicyObjectIdentifier:
+		m.PolicyObjectIdentifier = fwflex.StringValueToFramework(ctx, t.Value)
+
+	case awstypes.ApplicationPolicyMemberPolicyType:
+		m.PolicyType = fwtypes.StringEnumValue(t.Value)
+	}
+
+	return diags
+}
+
+type keyUsageModel struct {
+	Critical   types.Bool                                          `tfsdk:"critical"`
+	UsageFlags fwtypes.ListNestedObjectValueOf[keyUsageFlagsModel] `tfsdk:"usage_flags"`
+}
+
+type keyUsageFlagsModel struct {
+	DataEncipherment types.Bool `tfsdk:"data_encipherment"`
+	DigitalSignature types.Bool `tfsdk:"digital_signature"`
+	KeyAgreement     types.Bool `tfsdk:"key_agreement"`
+	KeyEncipherment  types.Bool `tfsdk:"key_encipherment"`
+	NonRepudiation   types.Bool `tfsdk:"non_repudiation"`
+}
+
+type generalFlagsModel struct {
+	AutoEnrollment types.Bool `tfsdk:"auto_enrollment"`
+	MachineType    types.Bool `tfsdk:"machine_type"`
+}
+
+type privateKeyAttributesV2Model struct {
+	CryptoProviders  fwtypes.SetOfString                  `tfsdk:"crypto_providers"`
+	KeySpec          fwtypes.StringEnum[awstypes.KeySpec] `tfsdk:"key_spec"`
+	MinimalKeyLength types.Int32                          `tfsdk:"minimal_key_length"`
+}
+
+// privateKeyAttributesModel is shared by the v3 and v4 template schemas.
+type privateKeyAttributesModel struct {
+	Algorithm        fwtypes.StringEnum[awstypes.PrivateKeyAlgorithm]       `tfsdk:"algorithm"`
+	CryptoProviders  fwtypes.SetOfString                                    `tfsdk:"crypto_providers"`
+	KeySpec          fwtypes.StringEnum[awstypes.KeySpec]                   `tfsdk:"key_spec"`
+	KeyUsageProperty fwtypes.ListNestedObjectValueOf[keyUsagePropertyModel] `tfsdk:"key_usage_property"`
+	MinimalKeyLength types.Int32                                            `tfsdk:"minimal_key_length"`
+}
+
+type keyUsagePropertyModel struct {
+	PropertyFlags fwtypes.ListNestedObjectValueOf[keyUsagePropertyFlagsModel] `tfsdk:"property_flags"`
+	PropertyType  fwtypes.StringEnum[awstypes.KeyUsagePropertyType]           `tfsdk:"property_type"`
+}
+
+var (
+	_ fwflex.Expander  = keyUsagePropertyModel{}
+	_ fwflex.Flattener = &keyUsagePropertyModel{}
+)
+
+func (m keyUsagePropertyModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.PropertyFlags.IsNull():
+		propertyFlagsData, d := m.PropertyFlags.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.KeyUsagePropertyMemberPropertyFlags
+		diags.Append(fwflex.Expand(ctx, propertyFlagsData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.PropertyType.IsNull():
+		return &awstypes.KeyUsagePropertyMemberPropertyType{
+			Value: m.PropertyType.ValueEnum(),
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *keyUsagePropertyModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.PropertyFlags = fwtypes.NewListNestedObjectValueOfNull[keyUsagePropertyFlagsModel](ctx)
+	m.PropertyType = fwtypes.StringEnumNull[awstypes.KeyUsagePropertyType]()
+
+	switch t := v.(type) {
+	case awstypes.KeyUsagePropertyMemberPropertyFlags:
+		var model keyUsagePropertyFlagsModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.PropertyFlags = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.KeyUsagePropertyMemberPropertyType:
+		m.PropertyType = fwtypes.StringEnumValue(t.Value)
+	}
+
+	return diags
+}
+
+type keyUsagePropertyFlagsModel struct {
+	Decrypt      types.Bool `tfsdk:"decrypt"`
+	KeyAgreement types.Bool `tfsdk:"key_agreement"`
+	Sign         types.Bool `tfsdk:"sign"`
+}
+
+type privateKeyFlagsV2Model struct {
+	ClientVersion               fwtypes.StringEnum[awstypes.ClientCompatibilityV2] `tfsdk:"client_version"`
+	ExportableKey               types.Bool                                         `tfsdk:"exportable_key"`
+	StrongKeyProtectionRequired types.Bool                                         `tfsdk:"strong_key_protection_required"`
+}
+
+type privateKeyFlagsV3Model struct {
+	ClientVersion                      fwtypes.StringEnum[awstypes.ClientCompatibilityV3] `tfsdk:"client_version"`
+	ExportableKey                      types.Bool                                         `tfsdk:"exportable_key"`
+	RequireAlternateSignatureAlgorithm types.Bool                                         `tfsdk:"require_alternate_signature_algorithm"`
+	StrongKeyProtectionRequired        types.Bool                                         `tfsdk:"strong_key_protection_required"`
+}
+
+type privateKeyFlagsV4Model struct {
+	ClientVersion                      fwtypes.StringEnum[awstypes.ClientCompatibilityV4] `tfsdk:"client_version"`
+	ExportableKey                      types.Bool                                         `tfsdk:"exportable_key"`
+	RequireAlternateSignatureAlgorithm types.Bool                                         `tfsdk:"require_alternate_signature_algorithm"`
+	RequireSameKeyRenewal              types.Bool                                         `tfsdk:"require_same_key_renewal"`
+	StrongKeyProtectionRequired        types.Bool                                         `tfsdk:"strong_key_protection_required"`
+	UseLegacyProvider                  types.Bool                                         `tfsdk:"use_legacy_provider"`
+}
+
+type subjectNameFlagsModel struct {
+	RequireCommonName       types.Bool `tfsdk:"require_common_name"`
+	RequireDirectoryPath    types.Bool `tfsdk:"require_directory_path"`
+	RequireDNSAsCN          types.Bool `tfsdk:"require_dns_as_cn"`
+	RequireEmail            types.Bool `tfsdk:"require_email"`
+	SanRequireDirectoryGUID types.Bool `tfsdk:"san_require_directory_guid"`
+	SanRequireDNS           types.Bool `tfsdk:"san_require_dns"`
+	SanRequireDomainDNS     types.Bool `tfsdk:"san_require_domain_dns"`
+	SanRequireEmail         types.Bool `tfsdk:"san_require_email"`
+	SanRequireSPN           types.Bool `tfsdk:"san_require_spn"`
+	SanRequireUPN           types.Bool `tfsdk:"san_require_upn"`
+}
@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func errorInfoError(apiObject *awstypes.ErrorInfo) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	return errs.APIError(aws.ToString(apiObject.Code), aws.ToString(apiObject.Message))
+}
+
+func errorInfosError(apiObjects []awstypes.ErrorInfo) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		if err := errorInfoError(&apiObject); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
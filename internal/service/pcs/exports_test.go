@@ -0,0 +1,15 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+// Exports for use in tests only.
+var (
+	ResourceCluster          = newClusterResource
+	ResourceComputeNodeGroup = newComputeNodeGroupResource
+	ResourceQueue            = newQueueResource
+
+	FindClusterByID                  = findClusterByID
+	FindComputeNodeGroupByTwoPartKey = findComputeNodeGroupByTwoPartKey
+	FindQueueByTwoPartKey            = findQueueByTwoPartKey
+)
@@ -0,0 +1,11 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package polly
+
+// Exports for use in tests only.
+var (
+	ResourceLexicon = newLexiconResource
+
+	FindLexiconByName = findLexiconByName
+)
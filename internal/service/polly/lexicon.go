@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package polly
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/polly"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/polly/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_polly_lexicon", name="Lexicon")
+func newLexiconResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &lexiconResource{}, nil
+}
+
+type lexiconResource struct {
+	framework.ResourceWithModel[lexiconResourceModel]
+}
+
+func (r *lexiconResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"alphabet": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrContent: schema.StringAttribute{
+				CustomType: fwtypes.XMLStringType,
+				Required:   true,
+			},
+			names.AttrLanguageCode: schema.StringAttribute{
+				Computed: true,
+			},
+			"last_modified": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"lexemes_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z]{1,20}$`), "must be up to 20 alphanumeric characters"),
+				},
+			},
+			names.AttrSize: schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *lexiconResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data lexiconResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PollyClient(ctx)
+
+	name := data.Name.ValueString()
+	if err := putLexicon(ctx, conn, name, data.Content.ValueString()); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	output, err := findLexiconByName(ctx, conn, name)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenLexiconAttributes(ctx, output.LexiconAttributes, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *lexiconResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data lexiconResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PollyClient(ctx)
+
+	name := data.Name.ValueString()
+	output, err := findLexiconByName(ctx, conn, name)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	data.Content = fwtypes.XMLStringValue(aws.ToString(output.Lexicon.Content))
+	data.Name = fwflex.StringToFramework(ctx, output.Lexicon.Name)
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenLexiconAttributes(ctx, output.LexiconAttributes, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *lexiconResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new lexiconResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PollyClient(ctx)
+
+	// PutLexicon overwrites an existing lexicon of the same name.
+	name := new.Name.ValueString()
+	if err := putLexicon(ctx, conn, name, new.Content.ValueString()); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	output, err := findLexiconByName(ctx, conn, name)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenLexiconAttributes(ctx, output.LexiconAttributes, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *lexiconResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data lexiconResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PollyClient(ctx)
+
+	name := data.Name.ValueString()
+	input := polly.DeleteLexiconInput{
+		Name: aws.String(name),
+	}
+	_, err := conn.DeleteLexicon(ctx, &input)
+
+	if errs.IsA[*awstypes.LexiconNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+}
+
+func (r *lexiconResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrName), request, response)
+}
+
+func putLexicon(ctx context.Context, conn *polly.Client, name, content string) error {
+	input := polly.PutLexiconInput{
+		Content: aws.String(content),
+		Name:    aws.String(name),
+	}
+	_, err := conn.PutLexicon(ctx, &input)
+
+	return smarterr.NewError(err)
+}
+
+func findLexiconByName(ctx context.Context, conn *polly.Client, name string) (*polly.GetLexiconOutput, error) {
+	input := polly.GetLexiconInput{
+		Name: aws.String(name),
+	}
+	output, err := conn.GetLexicon(ctx, &input)
+
+	if errs.IsA[*awstypes.LexiconNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Lexicon == nil || output.LexiconAttributes == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	return output, nil
+}
+
+func flattenLexiconAttributes(ctx context.Context, apiObject *awstypes.LexiconAttributes, data *lexiconResourceModel) diag.Diagnostics {
+	return fwflex.Flatten(ctx, apiObject, data, fwflex.WithFieldNamePrefix("Lexicon"))
+}
+
+type lexiconResourceModel struct {
+	framework.WithRegionModel
+	Alphabet     types.String      `tfsdk:"alphabet"`
+	ARN          types.String      `tfsdk:"arn"`
+	Content      fwtypes.XMLString `tfsdk:"content"`
+	LanguageCode types.String      `tfsdk:"language_code"`
+	LastModified timetypes.RFC3339 `tfsdk:"last_modified"`
+	LexemesCount types.Int64       `tfsdk:"lexemes_count"`
+	Name         types.String      `tfsdk:"name"`
+	Size         types.Int64       `tfsdk:"size"`
+}
@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package polly_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/polly"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfpolly "github.com/hashicorp/terraform-provider-aws/internal/service/polly"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPollyLexicon_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v polly.GetLexiconOutput
+	rName := sdkacctest.RandString(20)
+	resourceName := "aws_polly_lexicon.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PollyEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PollyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alphabet", "ipa"),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "polly", "lexicon/"+rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrLanguageCode, "en-US"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified"),
+					resource.TestCheckResourceAttr(resourceName, "lexemes_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrSize),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+		},
+	})
+}
+
+func TestAccPollyLexicon_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v polly.GetLexiconOutput
+	rName := sdkacctest.RandString(20)
+	resourceName := "aws_polly_lexicon.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PollyEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PollyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfpolly.ResourceLexicon, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPollyLexicon_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v polly.GetLexiconOutput
+	rName := sdkacctest.RandString(20)
+	resourceName := "aws_polly_lexicon.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PollyEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PollyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "lexemes_count", "1"),
+				),
+			},
+			{
+				Config: testAccLexiconConfig_reformatted(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccLexiconConfig_twoLexemes(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "lexemes_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLexiconDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PollyClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_polly_lexicon" {
+				continue
+			}
+
+			_, err := tfpolly.FindLexiconByName(ctx, conn, rs.Primary.Attributes[names.AttrName])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Polly Lexicon %s still exists", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLexiconExists(ctx context.Context, n string, v *polly.GetLexiconOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PollyClient(ctx)
+
+		output, err := tfpolly.FindLexiconByName(ctx, conn, rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLexiconConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_polly_lexicon" "test" {
+  name    = %[1]q
+  content = <<EOT
+<?xml version="1.0" encoding="UTF-8"?>
+<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="en-US">
+  <lexeme>
+    <grapheme>W3C</grapheme>
+    <alias>World Wide Web Consortium</alias>
+  </lexeme>
+</lexicon>
+EOT
+}
+`, rName)
+}
+
+// Equivalent to testAccLexiconConfig_basic apart from formatting, comments and attribute order.
+func testAccLexiconConfig_reformatted(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_polly_lexicon" "test" {
+  name    = %[1]q
+  content = <<EOT
+<lexicon xml:lang="en-US" alphabet="ipa" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" version="1.0">
+  <!-- Acronyms -->
+  <lexeme><grapheme>W3C</grapheme><alias>World Wide Web Consortium</alias></lexeme>
+</lexicon>
+EOT
+}
+`, rName)
+}
+
+func testAccLexiconConfig_twoLexemes(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_polly_lexicon" "test" {
+  name    = %[1]q
+  content = <<EOT
+<?xml version="1.0" encoding="UTF-8"?>
+<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="en-US">
+  <lexeme>
+    <grapheme>W3C</grapheme>
+    <alias>World Wide Web Consortium</alias>
+  </lexeme>
+  <lexeme>
+    <grapheme>IVR</grapheme>
+    <alias>Interactive Voice Response</alias>
+  </lexeme>
+</lexicon>
+EOT
+}
+`, rName)
+}
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package polly
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/service/polly"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/polly/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_polly_lexicons", name="Lexicons")
+func newLexiconsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &lexiconsDataSource{}, nil
+}
+
+type lexiconsDataSource struct {
+	framework.DataSourceWithModel[lexiconsDataSourceModel]
+}
+
+func (d *lexiconsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"lexicons": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[lexiconModel](ctx),
+				Computed:   true,
+			},
+			names.AttrNames: schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *lexiconsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data lexiconsDataSourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().PollyClient(ctx)
+
+	output, err := findLexicons(ctx, conn, &polly.ListLexiconsInput{})
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	lexicons := make([]*lexiconModel, 0, len(output))
+	var lexiconNames []string
+	for _, v := range output {
+		lexicon := &lexiconModel{}
+		if v.Attributes != nil {
+			smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, v.Attributes, lexicon, fwflex.WithFieldNamePrefix("Lexicon")))
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+		lexicon.Name = fwflex.StringToFramework(ctx, v.Name)
+
+		lexicons = append(lexicons, lexicon)
+		lexiconNames = append(lexiconNames, lexicon.Name.ValueString())
+	}
+
+	data.Lexicons = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, lexicons)
+	data.Names = fwflex.FlattenFrameworkStringValueListOfString(ctx, lexiconNames)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func findLexicons(ctx context.Context, conn *polly.Client, input *polly.ListLexiconsInput) ([]awstypes.LexiconDescription, error) {
+	var output []awstypes.LexiconDescription
+
+	// No paginator helper so pagination must be done manually.
+	for {
+		page, err := conn.ListLexicons(ctx, input)
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		output = append(output, page.Lexicons...)
+
+		if page.NextToken == nil {
+			break
+		}
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+type lexiconsDataSourceModel struct {
+	framework.WithRegionModel
+	Lexicons fwtypes.ListNestedObjectValueOf[lexiconModel] `tfsdk:"lexicons"`
+	Names    fwtypes.ListOfString                          `tfsdk:"names"`
+}
+
+type lexiconModel struct {
+	Alphabet     types.String      `tfsdk:"alphabet"`
+	ARN          types.String      `tfsdk:"arn"`
+	LanguageCode types.String      `tfsdk:"language_code"`
+	LastModified timetypes.RFC3339 `tfsdk:"last_modified"`
+	LexemesCount types.Int64       `tfsdk:"lexemes_count"`
+	Name         types.String      `tfsdk:"name"`
+	Size         types.Int64       `tfsdk:"size"`
+}
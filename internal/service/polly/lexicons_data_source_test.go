@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package polly_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPollyLexiconsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandString(20)
+	dataSourceName := "data.aws_polly_lexicons.test"
+	resourceName := "aws_polly_lexicon.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PollyEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PollyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", resourceName, names.AttrName),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "lexicons.*", map[string]string{
+						"alphabet":             "ipa",
+						names.AttrLanguageCode: "en-US",
+						"lexemes_count":        "1",
+						names.AttrName:         rName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccLexiconsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLexiconConfig_basic(rName), `
+data "aws_polly_lexicons" "test" {
+  depends_on = [aws_polly_lexicon.test]
+}
+`)
+}
@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package polly
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/service/polly"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/polly/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_polly_speech_synthesis", name="Speech Synthesis")
+func newSpeechSynthesisEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &speechSynthesisEphemeralResource{}, nil
+}
+
+type speechSynthesisEphemeralResource struct {
+	framework.EphemeralResourceWithModel[speechSynthesisEphemeralResourceModel]
+}
+
+func (e *speechSynthesisEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"audio_stream": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrContentType: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrEngine: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Engine](),
+				Optional:   true,
+			},
+			names.AttrLanguageCode: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LanguageCode](),
+				Optional:   true,
+			},
+			"lexicon_names": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"output_format": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.OutputFormat](),
+				Required:   true,
+			},
+			"request_characters": schema.Int32Attribute{
+				Computed: true,
+			},
+			"sample_rate": schema.StringAttribute{
+				Optional: true,
+			},
+			"text": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"text_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TextType](),
+				Optional:   true,
+			},
+			"voice_id": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.VoiceId](),
+				Required:   true,
+			},
+		},
+	}
+}
+
+func (e *speechSynthesisEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().PollyClient(ctx)
+	data := speechSynthesisEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var input polly.SynthesizeSpeechInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.SynthesizeSpeech(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, data.VoiceID.ValueString())
+		return
+	}
+	defer output.AudioStream.Close()
+
+	audio, err := io.ReadAll(output.AudioStream)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, data.VoiceID.ValueString())
+		return
+	}
+
+	data.AudioStream = fwflex.StringValueToFramework(ctx, base64.StdEncoding.EncodeToString(audio))
+	data.ContentType = fwflex.StringToFramework(ctx, output.ContentType)
+	data.RequestCharacters = types.Int32Value(output.RequestCharacters)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type speechSynthesisEphemeralResourceModel struct {
+	framework.WithRegionModel
+	AudioStream       types.String                              `tfsdk:"audio_stream"`
+	ContentType       types.String                              `tfsdk:"content_type"`
+	Engine            fwtypes.StringEnum[awstypes.Engine]       `tfsdk:"engine"`
+	LanguageCode      fwtypes.StringEnum[awstypes.LanguageCode] `tfsdk:"language_code"`
+	LexiconNames      fwtypes.ListOfString                      `tfsdk:"lexicon_names"`
+	OutputFormat      fwtypes.StringEnum[awstypes.OutputFormat] `tfsdk:"output_format"`
+	RequestCharacters types.Int32                               `tfsdk:"request_characters"`
+	SampleRate        types.String                              `tfsdk:"sample_rate"`
+	Text              types.String                              `tfsdk:"text"`
+	TextType          fwtypes.StringEnum[awstypes.TextType]     `tfsdk:"text_type"`
+	VoiceID           fwtypes.StringEnum[awstypes.VoiceId]      `tfsdk:"voice_id"`
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package polly
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/polly"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_polly_lexicon", sweepLexicons)
+}
+
+func sweepLexicons(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.PollyClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	lexicons, err := findLexicons(ctx, conn, &polly.ListLexiconsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range lexicons {
+		sweepResources = append(sweepResources, framework.NewSweepResource(newLexiconResource, client,
+			framework.NewAttribute(names.AttrName, aws.ToString(v.Name)),
+		))
+	}
+
+	return sweepResources, nil
+}
@@ -4,7 +4,19 @@
 package qbusiness
 
 var (
-	ResourceApplication = newApplicationResource
+	ResourceApplication   = newApplicationResource
+	ResourceDataAccessor  = newDataAccessorResource
+	ResourceDataSource    = newDataSourceResource
+	ResourceIndex         = newIndexResource
+	ResourcePlugin        = newPluginResource
+	ResourceRetriever     = newRetrieverResource
+	ResourceWebExperience = newWebExperienceResource
 
-	FindApplicationByID = findApplicationByID
+	FindApplicationByID           = findApplicationByID
+	FindDataAccessorByTwoPartKey  = findDataAccessorByTwoPartKey
+	FindDataSourceByThreePartKey  = findDataSourceByThreePartKey
+	FindIndexByTwoPartKey         = findIndexByTwoPartKey
+	FindPluginByTwoPartKey        = findPluginByTwoPartKey
+	FindRetrieverByTwoPartKey     = findRetrieverByTwoPartKey
+	FindWebExperienceByTwoPartKey = findWebExperienceByTwoPartKey
 )
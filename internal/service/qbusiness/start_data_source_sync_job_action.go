@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	dataSourceSyncJobPollInterval     = 30 * time.Second
+	dataSourceSyncJobProgressInterval = 2 * time.Minute
+
+	// A newly started sync job can take a short while to show up in the job history.
+	dataSourceSyncJobStatusPending = "PENDING"
+)
+
+// @Action(aws_qbusiness_start_data_source_sync_job, name="Start Data Source Sync Job")
+func newStartDataSourceSyncJobAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startDataSourceSyncJobAction{}, nil
+}
+
+var (
+	_ action.Action = (*startDataSourceSyncJobAction)(nil)
+)
+
+type startDataSourceSyncJobAction struct {
+	framework.ActionWithModel[startDataSourceSyncJobActionModel]
+}
+
+type startDataSourceSyncJobActionModel struct {
+	framework.WithRegionModel
+	ApplicationID types.String `tfsdk:"application_id"`
+	DataSourceID  types.String `tfsdk:"data_source_id"`
+	IndexID       types.String `tfsdk:"index_id"`
+	Timeout       types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *startDataSourceSyncJobAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an Amazon Q Business data source sync job and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "The ID of the Amazon Q Business application the data source belongs to.",
+				Required:    true,
+			},
+			"data_source_id": schema.StringAttribute{
+				Description: "The ID of the data source to synchronize.",
+				Required:    true,
+			},
+			"index_id": schema.StringAttribute{
+				Description: "The ID of the index the data source is attached to.",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Maximum time in seconds to wait for the sync job to complete. Defaults to 3600 seconds (60 minutes).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(60, 86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *startDataSourceSyncJobAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startDataSourceSyncJobActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().QBusinessClient(ctx)
+
+	applicationID, indexID, dataSourceID := config.ApplicationID.ValueString(), config.IndexID.ValueString(), config.DataSourceID.ValueString()
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting data source sync job action", map[string]any{
+		"application_id":  applicationID,
+		"data_source_id":  dataSourceID,
+		"index_id":        indexID,
+		"timeout_seconds": int64(timeout.Seconds()),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting sync job for data source %s...", dataSourceID),
+	})
+
+	input := qbusiness.StartDataSourceSyncJobInput{
+		ApplicationId: aws.String(applicationID),
+		DataSourceId:  aws.String(dataSourceID),
+		IndexId:       aws.String(indexID),
+	}
+	output, err := conn.StartDataSourceSyncJob(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Data Source Sync Job",
+			fmt.Sprintf("Could not start sync job for data source %s: %s", dataSourceID, err),
+		)
+		return
+	}
+
+	executionID := aws.ToString(output.ExecutionId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Data source sync job %s started, waiting for completion...", executionID),
+	})
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.DataSourceSyncJob], error) {
+		job, err := findDataSourceSyncJobByFourPartKey(ctx, conn, applicationID, indexID, dataSourceID, executionID)
+		if retry.NotFound(err) {
+			return actionwait.FetchResult[*awstypes.DataSourceSyncJob]{Status: dataSourceSyncJobStatusPending}, nil
+		}
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.DataSourceSyncJob]{}, fmt.Errorf("list data source sync jobs: %w", err)
+		}
+		return actionwait.FetchResult[*awstypes.DataSourceSyncJob]{Status: actionwait.Status(job.Status), Value: job}, nil
+	}, actionwait.Options[*awstypes.DataSourceSyncJob]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(dataSourceSyncJobPollInterval),
+		ProgressInterval: dataSourceSyncJobProgressInterval,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.DataSourceSyncJobStatusSucceeded),
+		},
+		TransitionalStates: []actionwait.Status{
+			dataSourceSyncJobStatusPending,
+			actionwait.Status(awstypes.DataSourceSyncJobStatusSyncing),
+			actionwait.Status(awstypes.DataSourceSyncJobStatusSyncingIndexing),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.DataSourceSyncJobStatusFailed),
+			actionwait.Status(awstypes.DataSourceSyncJobStatusAborted),
+			actionwait.Status(awstypes.DataSourceSyncJobStatusIncomplete),
+			actionwait.Status(awstypes.DataSourceSyncJobStatusStopping),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Data source sync job %s is currently %s", executionID, fr.Status)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Data Source Sync Job",
+				fmt.Sprintf("Data source sync job %s did not complete within %v", executionID, timeout),
+			)
+		} else if errors.As(err, &failureErr) {
+			message := ""
+			if fr.Value != nil && fr.Value.Error != nil {
+				message = aws.ToString(fr.Value.Error.ErrorMessage)
+			}
+			detail := fmt.Sprintf("Data source sync job %s failed with status %s: %s", executionID, failureErr.Status, message)
+			if fr.Value != nil && fr.Value.Metrics != nil {
+				metrics := fr.Value.Metrics
+				detail += fmt.Sprintf("\n\nDocuments scanned: %s, added: %s, modified: %s, deleted: %s, failed: %s",
+					aws.ToString(metrics.DocumentsScanned), aws.ToString(metrics.DocumentsAdded), aws.ToString(metrics.DocumentsModified), aws.ToString(metrics.DocumentsDeleted), aws.ToString(metrics.DocumentsFailed))
+			}
+			resp.Diagnostics.AddError(
+				"Data Source Sync Job Failed",
+				detail,
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Data Source Sync Job Status",
+				fmt.Sprintf("Data source sync job %s entered unexpected status: %s", executionID, unexpectedErr.Status),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Data Source Sync Job",
+				fmt.Sprintf("Error while waiting for data source sync job %s: %s", executionID, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Data source sync job %s finished with status %s", executionID, fr.Status)})
+	tflog.Info(ctx, "Data source sync job completed", map[string]any{
+		"data_source_id": dataSourceID,
+		"execution_id":   executionID,
+		"job_status":     fr.Status,
+	})
+}
+
+func findDataSourceSyncJobByFourPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID, executionID string) (*awstypes.DataSourceSyncJob, error) {
+	input := qbusiness.ListDataSourceSyncJobsInput{
+		ApplicationId: aws.String(applicationID),
+		DataSourceId:  aws.String(dataSourceID),
+		IndexId:       aws.String(indexID),
+	}
+
+	pages := qbusiness.NewListDataSourceSyncJobsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		for _, v := range page.History {
+			if aws.ToString(v.ExecutionId) == executionID {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, smarterr.NewError(&retry.NotFoundError{})
+}
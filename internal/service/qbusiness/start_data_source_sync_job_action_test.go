@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQBusinessStartDataSourceSyncJobAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_data_source.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckApplication(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartDataSourceSyncJobActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceSyncJobStatus(ctx, t, resourceName, "SUCCEEDED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceSyncJobStatus(ctx context.Context, t *testing.T, n string, expectedStatuses ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).QBusinessClient(ctx)
+
+		input := qbusiness.ListDataSourceSyncJobsInput{
+			ApplicationId: aws.String(rs.Primary.Attributes["application_id"]),
+			DataSourceId:  aws.String(rs.Primary.Attributes["data_source_id"]),
+			IndexId:       aws.String(rs.Primary.Attributes["index_id"]),
+		}
+		output, err := conn.ListDataSourceSyncJobs(ctx, &input)
+
+		if err != nil {
+			return fmt.Errorf("error listing sync jobs for data source %s: %w", rs.Primary.ID, err)
+		}
+
+		if len(output.History) == 0 {
+			return fmt.Errorf("no sync jobs found for data source %s", rs.Primary.ID)
+		}
+
+		actualStatus := string(output.History[0].Status)
+		if slices.Contains(expectedStatuses, actualStatus) {
+			return nil
+		}
+
+		return fmt.Errorf("expected data source %s sync job status to be one of %v, got %s", rs.Primary.ID, expectedStatuses, actualStatus)
+	}
+}
+
+func testAccStartDataSourceSyncJobActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDataSourceConfig_basic(rName, rName), `
+action "aws_qbusiness_start_data_source_sync_job" "test" {
+  config {
+    application_id = aws_qbusiness_data_source.test.application_id
+    index_id       = aws_qbusiness_data_source.test.index_id
+    data_source_id = aws_qbusiness_data_source.test.data_source_id
+    timeout        = 3600
+  }
+}
+
+resource "terraform_data" "test" {
+  input = "completed"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_qbusiness_start_data_source_sync_job.test]
+    }
+  }
+
+  depends_on = [aws_qbusiness_data_source.test]
+}
+`)
+}
@@ -0,0 +1,332 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdsdata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rdsdata"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rdsdata/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rdsdata_execute_statement, name="Execute Statement")
+func newExecuteStatementAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &executeStatementAction{}, nil
+}
+
+var (
+	_ action.Action = (*executeStatementAction)(nil)
+)
+
+type executeStatementAction struct {
+	framework.ActionWithModel[executeStatementActionModel]
+}
+
+type executeStatementActionModel struct {
+	framework.WithRegionModel
+	Database     types.String                                       `tfsdk:"database"`
+	Parameters   fwtypes.ListNestedObjectValueOf[sqlParameterModel] `tfsdk:"parameter"`
+	ParameterSet fwtypes.ListNestedObjectValueOf[parameterSetModel] `tfsdk:"parameter_set"`
+	ResourceARN  fwtypes.ARN                                        `tfsdk:"resource_arn"`
+	Schema       types.String                                       `tfsdk:"schema"`
+	SecretARN    fwtypes.ARN                                        `tfsdk:"secret_arn"`
+	SQL          types.String                                       `tfsdk:"sql"`
+	Transaction  types.Bool                                         `tfsdk:"transaction"`
+}
+
+type parameterSetModel struct {
+	Parameters fwtypes.ListNestedObjectValueOf[sqlParameterModel] `tfsdk:"parameter"`
+}
+
+type sqlParameterModel struct {
+	Name     types.String                          `tfsdk:"name"`
+	TypeHint fwtypes.StringEnum[awstypes.TypeHint] `tfsdk:"type_hint"`
+	Value    types.String                          `tfsdk:"value"`
+}
+
+func (a *executeStatementAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a SQL statement against an Aurora cluster using the RDS Data API.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrDatabase: schema.StringAttribute{
+				Description: "The name of the database.",
+				Optional:    true,
+			},
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the Aurora DB cluster.",
+				Required:    true,
+			},
+			names.AttrSchema: schema.StringAttribute{
+				Description: "The name of the database schema.",
+				Optional:    true,
+			},
+			"secret_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the Secrets Manager secret that enables access to the DB cluster.",
+				Required:    true,
+			},
+			"sql": schema.StringAttribute{
+				Description: "The SQL statement to run.",
+				Required:    true,
+			},
+			"transaction": schema.BoolAttribute{
+				Description: "Whether to run the statement in a transaction that is committed on success and rolled back on failure.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"parameter": actionSQLParameterBlock(ctx, "The parameters for the SQL statement."),
+			"parameter_set": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[parameterSetModel](ctx),
+				Description: "A set of parameters for a batch run of the SQL statement. The statement runs once for each parameter set.",
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("parameter")),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"parameter": actionSQLParameterBlock(ctx, "The parameters for one run of the SQL statement."),
+					},
+				},
+			},
+		},
+	}
+}
+
+func actionSQLParameterBlock(ctx context.Context, description string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType:  fwtypes.NewListNestedObjectTypeOf[sqlParameterModel](ctx),
+		Description: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrName: schema.StringAttribute{
+					Description: "The name of the parameter.",
+					Required:    true,
+				},
+				"type_hint": schema.StringAttribute{
+					CustomType:  fwtypes.StringEnumType[awstypes.TypeHint](),
+					Description: "A hint that specifies the database type the value is sent as.",
+					Optional:    true,
+				},
+				names.AttrValue: schema.StringAttribute{
+					Description: "The value of the parameter. Omit to send NULL.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func (a *executeStatementAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config executeStatementActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSDataClient(ctx)
+
+	resourceARN := config.ResourceARN.ValueString()
+
+	tflog.Info(ctx, "Starting execute statement action", map[string]any{
+		"resource_arn":  resourceARN,
+		"transaction":   config.Transaction.ValueBool(),
+		"parameter_set": len(config.ParameterSet.Elements()),
+	})
+
+	var transactionID *string
+	if config.Transaction.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Beginning transaction on %s...", resourceARN),
+		})
+
+		input := rdsdata.BeginTransactionInput{
+			Database:    config.Database.ValueStringPointer(),
+			ResourceArn: aws.String(resourceARN),
+			Schema:      config.Schema.ValueStringPointer(),
+			SecretArn:   config.SecretARN.ValueStringPointer(),
+		}
+		output, err := conn.BeginTransaction(ctx, &input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Begin Transaction",
+				fmt.Sprintf("Could not begin transaction on %s: %s", resourceARN, err),
+			)
+			return
+		}
+
+		transactionID = output.TransactionId
+	}
+
+	message, diags := a.execute(ctx, conn, &config, transactionID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		if transactionID != nil {
+			resp.Diagnostics.Append(a.rollback(ctx, conn, &config, transactionID, fwdiag.DiagnosticsError(diags), resp)...)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: message})
+
+	if transactionID != nil {
+		input := rdsdata.CommitTransactionInput{
+			ResourceArn:   aws.String(resourceARN),
+			SecretArn:     config.SecretARN.ValueStringPointer(),
+			TransactionId: transactionID,
+		}
+		output, err := conn.CommitTransaction(ctx, &input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Commit Transaction",
+				fmt.Sprintf("Could not commit transaction %s on %s: %s", aws.ToString(transactionID), resourceARN, err),
+			)
+			resp.Diagnostics.Append(a.rollback(ctx, conn, &config, transactionID, err, resp)...)
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Transaction %s: %s", aws.ToString(transactionID), aws.ToString(output.TransactionStatus)),
+		})
+	}
+
+	tflog.Info(ctx, "Execute statement action completed successfully", map[string]any{
+		"resource_arn": resourceARN,
+	})
+}
+
+// rollback rolls back the transaction after cause made it fail.
+// The rollback is sent even if ctx has been cancelled so that the transaction isn't left open.
+func (a *executeStatementAction) rollback(ctx context.Context, conn *rdsdata.Client, config *executeStatementActionModel, transactionID *string, cause error, resp *action.InvokeResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	resourceARN := config.ResourceARN.ValueString()
+
+	input := rdsdata.RollbackTransactionInput{
+		ResourceArn:   aws.String(resourceARN),
+		SecretArn:     config.SecretARN.ValueStringPointer(),
+		TransactionId: transactionID,
+	}
+	if _, err := conn.RollbackTransaction(context.WithoutCancel(ctx), &input); err != nil {
+		diags.AddError(
+			"Failed to Roll Back Transaction",
+			fmt.Sprintf("Could not roll back transaction %s on %s: %s\n\nThe transaction was being rolled back because: %s", aws.ToString(transactionID), resourceARN, err, cause),
+		)
+		return diags
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Transaction %s rolled back", aws.ToString(transactionID)),
+	})
+
+	return diags
+}
+
+// execute runs the statement once, or once per parameter set when any are configured,
+// returning a summary message for progress reporting.
+func (a *executeStatementAction) execute(ctx context.Context, conn *rdsdata.Client, config *executeStatementActionModel, transactionID *string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	resourceARN := config.ResourceARN.ValueString()
+
+	if parameterSets, d := config.ParameterSet.ToSlice(ctx); d.HasError() {
+		diags.Append(d...)
+		return "", diags
+	} else if len(parameterSets) > 0 {
+		input := rdsdata.BatchExecuteStatementInput{
+			Database:      config.Database.ValueStringPointer(),
+			ResourceArn:   aws.String(resourceARN),
+			Schema:        config.Schema.ValueStringPointer(),
+			SecretArn:     config.SecretARN.ValueStringPointer(),
+			Sql:           config.SQL.ValueStringPointer(),
+			TransactionId: transactionID,
+		}
+
+		for _, parameterSet := range parameterSets {
+			parameters, d := expandSQLParameters(ctx, parameterSet.Parameters)
+			diags.Append(d...)
+			if diags.HasError() {
+				return "", diags
+			}
+
+			input.ParameterSets = append(input.ParameterSets, parameters)
+		}
+
+		output, err := conn.BatchExecuteStatement(ctx, &input)
+		if err != nil {
+			diags.AddError(
+				"Failed to Execute Statement",
+				fmt.Sprintf("Could not run batch statement on %s: %s", resourceARN, err),
+			)
+			return "", diags
+		}
+
+		return fmt.Sprintf("Batch statement ran with %d parameter sets (%d update results)", len(input.ParameterSets), len(output.UpdateResults)), diags
+	}
+
+	parameters, d := expandSQLParameters(ctx, config.Parameters)
+	diags.Append(d...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	input := rdsdata.ExecuteStatementInput{
+		Database:      config.Database.ValueStringPointer(),
+		Parameters:    parameters,
+		ResourceArn:   aws.String(resourceARN),
+		Schema:        config.Schema.ValueStringPointer(),
+		SecretArn:     config.SecretARN.ValueStringPointer(),
+		Sql:           config.SQL.ValueStringPointer(),
+		TransactionId: transactionID,
+	}
+
+	output, err := conn.ExecuteStatement(ctx, &input)
+	if err != nil {
+		diags.AddError(
+			"Failed to Execute Statement",
+			fmt.Sprintf("Could not run statement on %s: %s", resourceARN, err),
+		)
+		return "", diags
+	}
+
+	return fmt.Sprintf("Statement ran successfully (%d records updated)", output.NumberOfRecordsUpdated), diags
+}
+
+func expandSQLParameters(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[sqlParameterModel]) ([]awstypes.SqlParameter, diag.Diagnostics) {
+	data, diags := tfList.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make([]awstypes.SqlParameter, 0, len(data))
+	for _, v := range data {
+		apiObject := awstypes.SqlParameter{
+			Name:     v.Name.ValueStringPointer(),
+			TypeHint: v.TypeHint.ValueEnum(),
+		}
+
+		if v.Value.IsNull() {
+			apiObject.Value = &awstypes.FieldMemberIsNull{Value: true}
+		} else {
+			apiObject.Value = &awstypes.FieldMemberStringValue{Value: v.Value.ValueString()}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, diags
+}
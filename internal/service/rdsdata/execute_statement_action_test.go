@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdsdata_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rdsdata"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rdsdata/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSDataExecuteStatementAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.RDSDataServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExecuteStatementActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableRowCount(ctx, t, "aws_rds_cluster.test", "test_table", 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableRowCount(ctx context.Context, t *testing.T, n, table string, expected int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).RDSDataClient(ctx)
+
+		input := rdsdata.ExecuteStatementInput{
+			Database:    aws.String(rs.Primary.Attributes[names.AttrDatabaseName]),
+			ResourceArn: aws.String(rs.Primary.Attributes[names.AttrARN]),
+			SecretArn:   aws.String(rs.Primary.Attributes["master_user_secret.0.secret_arn"]),
+			Sql:         aws.String(fmt.Sprintf("SELECT COUNT(*) FROM %s", table)),
+		}
+		output, err := conn.ExecuteStatement(ctx, &input)
+
+		if err != nil {
+			return fmt.Errorf("querying %s: %w", table, err)
+		}
+
+		if len(output.Records) != 1 || len(output.Records[0]) != 1 {
+			return fmt.Errorf("unexpected result shape querying %s: %v", table, output.Records)
+		}
+
+		v, ok := output.Records[0][0].(*awstypes.FieldMemberLongValue)
+		if !ok {
+			return fmt.Errorf("unexpected result type querying %s: %T", table, output.Records[0][0])
+		}
+
+		if v.Value != expected {
+			return fmt.Errorf("expected %d rows in %s, got %d", expected, table, v.Value)
+		}
+
+		return nil
+	}
+}
+
+func testAccClusterConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = "aurora-postgresql"
+  engine_latest_version      = true
+  preferred_instance_classes = ["db.serverless"]
+}
+
+resource "aws_db_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier          = %[1]q
+  database_name               = "test"
+  db_subnet_group_name        = aws_db_subnet_group.test.name
+  enable_http_endpoint        = true
+  engine                      = data.aws_rds_orderable_db_instance.test.engine
+  engine_version              = data.aws_rds_orderable_db_instance.test.engine_version
+  manage_master_user_password = true
+  master_username             = "tfacctest"
+  skip_final_snapshot         = true
+
+  serverlessv2_scaling_configuration {
+    max_capacity = 1.0
+    min_capacity = 0.5
+  }
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  cluster_identifier = aws_rds_cluster.test.id
+  engine             = aws_rds_cluster.test.engine
+  engine_version     = aws_rds_cluster.test.engine_version
+  instance_class     = "db.serverless"
+}
+`, rName))
+}
+
+func testAccExecuteStatementActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), `
+action "aws_rdsdata_execute_statement" "create_table" {
+  config {
+    database     = aws_rds_cluster.test.database_name
+    resource_arn = aws_rds_cluster.test.arn
+    secret_arn   = aws_rds_cluster.test.master_user_secret[0].secret_arn
+    sql          = "CREATE TABLE IF NOT EXISTS test_table (id INTEGER PRIMARY KEY, name VARCHAR(64))"
+  }
+}
+
+action "aws_rdsdata_execute_statement" "insert_rows" {
+  config {
+    database     = aws_rds_cluster.test.database_name
+    resource_arn = aws_rds_cluster.test.arn
+    secret_arn   = aws_rds_cluster.test.master_user_secret[0].secret_arn
+    sql          = "INSERT INTO test_table (id, name) VALUES (CAST(:id AS INTEGER), :name)"
+    transaction  = true
+
+    parameter_set {
+      parameter {
+        name  = "id"
+        value = "1"
+      }
+      parameter {
+        name  = "name"
+        value = "one"
+      }
+    }
+
+    parameter_set {
+      parameter {
+        name  = "id"
+        value = "2"
+      }
+      parameter {
+        name = "name"
+      }
+    }
+  }
+}
+
+resource "terraform_data" "test" {
+  input = "completed"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.aws_rdsdata_execute_statement.create_table, action.aws_rdsdata_execute_statement.insert_rows]
+    }
+  }
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`)
+}
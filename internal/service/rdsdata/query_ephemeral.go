@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdsdata
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rdsdata"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rdsdata/types"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_rdsdata_query, name="Query")
+func newQueryEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &queryEphemeralResource{}, nil
+}
+
+type queryEphemeralResource struct {
+	framework.EphemeralResourceWithModel[queryEphemeralResourceModel]
+}
+
+func (e *queryEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDatabase: schema.StringAttribute{
+				Optional: true,
+			},
+			"number_of_records_updated": schema.Int64Attribute{
+				Computed: true,
+			},
+			"records": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrSchema: schema.StringAttribute{
+				Optional: true,
+			},
+			"secret_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"sql": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"parameter": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sqlParameterModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"type_hint": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.TypeHint](),
+							Optional:   true,
+						},
+						names.AttrValue: schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *queryEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().RDSDataClient(ctx)
+	data := queryEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := expandSQLParameters(ctx, data.Parameters)
+	smerr.AddEnrich(ctx, &response.Diagnostics, diags)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := rdsdata.ExecuteStatementInput{
+		Database:        data.Database.ValueStringPointer(),
+		FormatRecordsAs: awstypes.RecordsFormatTypeJson,
+		Parameters:      parameters,
+		ResourceArn:     data.ResourceARN.ValueStringPointer(),
+		Schema:          data.Schema.ValueStringPointer(),
+		SecretArn:       data.SecretARN.ValueStringPointer(),
+		Sql:             data.SQL.ValueStringPointer(),
+	}
+
+	output, err := conn.ExecuteStatement(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, data.ResourceARN.ValueString())
+		return
+	}
+
+	data.NumberOfRecordsUpdated = fwflex.Int64ValueToFramework(ctx, output.NumberOfRecordsUpdated)
+	// FormattedRecords is absent for statements that return no result set.
+	data.Records = fwflex.StringValueToFramework(ctx, aws.ToString(output.FormattedRecords))
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type queryEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Database               types.String                                       `tfsdk:"database"`
+	NumberOfRecordsUpdated types.Int64                                        `tfsdk:"number_of_records_updated"`
+	Parameters             fwtypes.ListNestedObjectValueOf[sqlParameterModel] `tfsdk:"parameter"`
+	Records                types.String                                       `tfsdk:"records"`
+	ResourceARN            fwtypes.ARN                                        `tfsdk:"resource_arn"`
+	Schema                 types.String                                       `tfsdk:"schema"`
+	SecretARN              fwtypes.ARN                                        `tfsdk:"secret_arn"`
+	SQL                    types.String                                       `tfsdk:"sql"`
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdsdata_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSDataQueryEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.RDSDataServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryEphemeralConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("number_of_records_updated"), knownvalue.NumberExact(big.NewFloat(0))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("records"), knownvalue.StringExact(`[{"answer":42,"greeting":"hello"}]`)),
+				},
+			},
+		},
+	})
+}
+
+func testAccQueryEphemeralConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfig_base(rName),
+		acctest.ConfigWithEchoProvider("ephemeral.aws_rdsdata_query.test"),
+		`
+ephemeral "aws_rdsdata_query" "test" {
+  database     = aws_rds_cluster.test.database_name
+  resource_arn = aws_rds_cluster.test.arn
+  secret_arn   = aws_rds_cluster.test.master_user_secret[0].secret_arn
+  sql          = "SELECT 42 AS answer, CAST(:greeting AS VARCHAR) AS greeting"
+
+  parameter {
+    name  = "greeting"
+    value = "hello"
+  }
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`)
+}
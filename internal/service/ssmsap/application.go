@@ -0,0 +1,503 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmsap"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssmsap/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ssmsap_application", name="Application")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newApplicationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &applicationResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type applicationResource struct {
+	framework.ResourceWithModel[applicationResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *applicationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app_registry_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 60),
+				},
+			},
+			"application_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ApplicationType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"components": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"instances": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"sap_instance_number": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sid": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"component_info": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[componentInfoModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"component_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ComponentType](),
+							Required:   true,
+						},
+						"ec2_instance_id": schema.StringAttribute{
+							Required: true,
+						},
+						"sid": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"credential": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[applicationCredentialModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"credential_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.CredentialType](),
+							Required:   true,
+						},
+						names.AttrDatabaseName: schema.StringAttribute{
+							Required: true,
+						},
+						"secret_id": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *applicationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data applicationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMSAPClient(ctx)
+
+	id := data.ApplicationID.ValueString()
+	var input ssmsap.RegisterApplicationInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.RegisterApplication(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	application, err := waitApplicationCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrApplicationID), id) // Set 'application_id' so as to taint.
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	// Set values for unknowns.
+	data.AppRegistryARN = fwflex.StringToFramework(ctx, application.AppRegistryArn)
+	data.ARN = fwflex.StringToFramework(ctx, application.Arn)
+	data.Components = fwflex.FlattenFrameworkStringValueSetOfString(ctx, application.Components)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *applicationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data applicationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMSAPClient(ctx)
+
+	id := data.ApplicationID.ValueString()
+	output, err := findApplicationByID(ctx, conn, id)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Application")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *applicationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old applicationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMSAPClient(ctx)
+
+	if !new.Credentials.Equal(old.Credentials) || !new.DatabaseARN.Equal(old.DatabaseARN) {
+		id := new.ApplicationID.ValueString()
+		input := ssmsap.UpdateApplicationSettingsInput{
+			ApplicationId: aws.String(id),
+			DatabaseArn:   fwflex.StringFromFramework(ctx, new.DatabaseARN),
+		}
+
+		var newCredentials, oldCredentials []awstypes.ApplicationCredential
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new.Credentials, &newCredentials))
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, old.Credentials, &oldCredentials))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		for _, v := range newCredentials {
+			if !slices.ContainsFunc(oldCredentials, func(o awstypes.ApplicationCredential) bool {
+				return applicationCredentialEqual(o, v)
+			}) {
+				input.CredentialsToAddOrUpdate = append(input.CredentialsToAddOrUpdate, v)
+			}
+		}
+		for _, v := range oldCredentials {
+			if !slices.ContainsFunc(newCredentials, func(n awstypes.ApplicationCredential) bool {
+				return n.CredentialType == v.CredentialType && aws.ToString(n.DatabaseName) == aws.ToString(v.DatabaseName)
+			}) {
+				input.CredentialsToRemove = append(input.CredentialsToRemove, v)
+			}
+		}
+
+		output, err := conn.UpdateApplicationSettings(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+
+		for _, operationID := range output.OperationIds {
+			if _, err := waitOperationSucceeded(ctx, conn, operationID, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+				return
+			}
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *applicationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data applicationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMSAPClient(ctx)
+
+	id := data.ApplicationID.ValueString()
+	input := ssmsap.DeregisterApplicationInput{
+		ApplicationId: aws.String(id),
+	}
+	_, err := conn.DeregisterApplication(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	if _, err := waitApplicationDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func (r *applicationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrApplicationID), request, response)
+}
+
+func applicationCredentialEqual(x, y awstypes.ApplicationCredential) bool {
+	return x.CredentialType == y.CredentialType && aws.ToString(x.DatabaseName) == aws.ToString(y.DatabaseName) && aws.ToString(x.SecretId) == aws.ToString(y.SecretId)
+}
+
+func findApplicationByID(ctx context.Context, conn *ssmsap.Client, id string) (*awstypes.Application, error) {
+	input := ssmsap.GetApplicationInput{
+		ApplicationId: aws.String(id),
+	}
+
+	output, err := conn.GetApplication(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Application == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	return output.Application, nil
+}
+
+func statusApplication(conn *ssmsap.Client, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findApplicationByID(ctx, conn, id)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", smarterr.NewError(err)
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitApplicationCreated(ctx context.Context, conn *ssmsap.Client, id string, timeout time.Duration) (*awstypes.Application, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ApplicationStatusRegistering, awstypes.ApplicationStatusStarting),
+		Target:  enum.Slice(awstypes.ApplicationStatusActivated),
+		Refresh: statusApplication(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Application); ok {
+		retry.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitApplicationDeleted(ctx context.Context, conn *ssmsap.Client, id string, timeout time.Duration) (*awstypes.Application, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ApplicationStatusDeleting, awstypes.ApplicationStatusActivated, awstypes.ApplicationStatusStopped, awstypes.ApplicationStatusFailed),
+		Target:  []string{},
+		Refresh: statusApplication(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Application); ok {
+		retry.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func findOperationByID(ctx context.Context, conn *ssmsap.Client, id string) (*awstypes.Operation, error) {
+	input := ssmsap.GetOperationInput{
+		OperationId: aws.String(id),
+	}
+
+	output, err := conn.GetOperation(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Operation == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	return output.Operation, nil
+}
+
+func statusOperation(conn *ssmsap.Client, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findOperationByID(ctx, conn, id)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", smarterr.NewError(err)
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitOperationSucceeded(ctx context.Context, conn *ssmsap.Client, id string, timeout time.Duration) (*awstypes.Operation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.OperationStatusInprogress),
+		Target:  enum.Slice(awstypes.OperationStatusSuccess),
+		Refresh: statusOperation(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Operation); ok {
+		retry.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+type applicationResourceModel struct {
+	framework.WithRegionModel
+	AppRegistryARN    types.String                                               `tfsdk:"app_registry_arn"`
+	ApplicationID     types.String                                               `tfsdk:"application_id"`
+	ApplicationType   fwtypes.StringEnum[awstypes.ApplicationType]               `tfsdk:"application_type"`
+	ARN               types.String                                               `tfsdk:"arn"`
+	Components        fwtypes.SetOfString                                        `tfsdk:"components"`
+	ComponentsInfo    fwtypes.ListNestedObjectValueOf[componentInfoModel]        `tfsdk:"component_info"`
+	Credentials       fwtypes.SetNestedObjectValueOf[applicationCredentialModel] `tfsdk:"credential"`
+	DatabaseARN       fwtypes.ARN                                                `tfsdk:"database_arn"`
+	Instances         fwtypes.SetOfString                                        `tfsdk:"instances"`
+	SAPInstanceNumber types.String                                               `tfsdk:"sap_instance_number"`
+	SID               types.String                                               `tfsdk:"sid"`
+	Tags              tftags.Map                                                 `tfsdk:"tags"`
+	TagsAll           tftags.Map                                                 `tfsdk:"tags_all"`
+	Timeouts          timeouts.Value                                             `tfsdk:"timeouts"`
+}
+
+type componentInfoModel struct {
+	ComponentType fwtypes.StringEnum[awstypes.ComponentType] `tfsdk:"component_type"`
+	EC2InstanceID types.String                               `tfsdk:"ec2_instance_id"`
+	SID           types.String                               `tfsdk:"sid"`
+}
+
+type applicationCredentialModel struct {
+	CredentialType fwtypes.StringEnum[awstypes.CredentialType] `tfsdk:"credential_type"`
+	DatabaseName   types.String                                `tfsdk:"database_name"`
+	SecretID       types.String                                `tfsdk:"secret_id"`
+}
@@ -0,0 +1,289 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/ssmsap"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssmsap/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfssmsap "github.com/hashicorp/terraform-provider-aws/internal/service/ssmsap"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Registering an application requires an EC2 instance running SAP HANA with
+// the SSM Agent installed and database credentials stored in Secrets Manager.
+const (
+	envVarHANAInstanceID     = "AWS_SSMSAP_HANA_INSTANCE_ID"
+	envVarHANAInstanceNumber = "AWS_SSMSAP_HANA_INSTANCE_NUMBER"
+	envVarHANASecretID       = "AWS_SSMSAP_HANA_SECRET_ID"
+	envVarHANASID            = "AWS_SSMSAP_HANA_SID"
+)
+
+type testAccHANAEnvironment struct {
+	instanceID     string
+	instanceNumber string
+	secretID       string
+	sid            string
+}
+
+func testAccHANAEnvironmentFromEnv(t *testing.T) testAccHANAEnvironment {
+	t.Helper()
+
+	return testAccHANAEnvironment{
+		instanceID:     acctest.SkipIfEnvVarNotSet(t, envVarHANAInstanceID),
+		instanceNumber: acctest.SkipIfEnvVarNotSet(t, envVarHANAInstanceNumber),
+		secretID:       acctest.SkipIfEnvVarNotSet(t, envVarHANASecretID),
+		sid:            acctest.SkipIfEnvVarNotSet(t, envVarHANASID),
+	}
+}
+
+func TestAccSSMSAPApplication_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	env := testAccHANAEnvironmentFromEnv(t)
+	var v awstypes.Application
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmsap_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMSAPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName, env),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "app_registry_arn"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrApplicationID, rName),
+					resource.TestCheckResourceAttr(resourceName, "application_type", "HANA"),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ssm-sap", regexache.MustCompile(`HANA/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "credential.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instances.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sid", env.sid),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrApplicationID),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrApplicationID,
+				ImportStateVerifyIgnore:              []string{"credential", "instances", "sap_instance_number", "sid"},
+			},
+		},
+	})
+}
+
+func TestAccSSMSAPApplication_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	env := testAccHANAEnvironmentFromEnv(t)
+	var v awstypes.Application
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmsap_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMSAPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName, env),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfssmsap.ResourceApplication, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMSAPApplication_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	env := testAccHANAEnvironmentFromEnv(t)
+	var v awstypes.Application
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmsap_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMSAPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_tags1(rName, env, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccApplicationConfig_tags2(rName, env, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccApplicationConfig_tags1(rName, env, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMSAPClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssmsap_application" {
+				continue
+			}
+
+			_, err := tfssmsap.FindApplicationByID(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSM for SAP Application %s still exists", rs.Primary.Attributes[names.AttrApplicationID])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckApplicationExists(ctx context.Context, n string, v *awstypes.Application) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMSAPClient(ctx)
+
+		output, err := tfssmsap.FindApplicationByID(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMSAPClient(ctx)
+
+	input := ssmsap.ListApplicationsInput{}
+	_, err := conn.ListApplications(ctx, &input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccApplicationConfig_basic(rName string, env testAccHANAEnvironment) string {
+	return fmt.Sprintf(`
+resource "aws_ssmsap_application" "test" {
+  application_id      = %[1]q
+  application_type    = "HANA"
+  instances           = [%[2]q]
+  sap_instance_number = %[3]q
+  sid                 = %[5]q
+
+  credential {
+    credential_type = "ADMIN"
+    database_name   = "SYSTEMDB"
+    secret_id       = %[4]q
+  }
+}
+`, rName, env.instanceID, env.instanceNumber, env.secretID, env.sid)
+}
+
+func testAccApplicationConfig_tags1(rName string, env testAccHANAEnvironment, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssmsap_application" "test" {
+  application_id      = %[1]q
+  application_type    = "HANA"
+  instances           = [%[2]q]
+  sap_instance_number = %[3]q
+  sid                 = %[5]q
+
+  credential {
+    credential_type = "ADMIN"
+    database_name   = "SYSTEMDB"
+    secret_id       = %[4]q
+  }
+
+  tags = {
+    %[6]q = %[7]q
+  }
+}
+`, rName, env.instanceID, env.instanceNumber, env.secretID, env.sid, tagKey1, tagValue1)
+}
+
+func testAccApplicationConfig_tags2(rName string, env testAccHANAEnvironment, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssmsap_application" "test" {
+  application_id      = %[1]q
+  application_type    = "HANA"
+  instances           = [%[2]q]
+  sap_instance_number = %[3]q
+  sid                 = %[5]q
+
+  credential {
+    credential_type = "ADMIN"
+    database_name   = "SYSTEMDB"
+    secret_id       = %[4]q
+  }
+
+  tags = {
+    %[6]q = %[7]q
+    %[8]q = %[9]q
+  }
+}
+`, rName, env.instanceID, env.instanceNumber, env.secretID, env.sid, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/service/ssmsap"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssmsap/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ssmsap_components", name="Components")
+func newComponentsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &componentsDataSource{}, nil
+}
+
+type componentsDataSource struct {
+	framework.DataSourceWithModel[componentsDataSourceModel]
+}
+
+func (d *componentsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+			},
+			"components": framework.DataSourceComputedListOfObjectAttribute[componentSummaryModel](ctx),
+		},
+	}
+}
+
+func (d *componentsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data componentsDataSourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SSMSAPClient(ctx)
+
+	applicationID := data.ApplicationID.ValueString()
+	input := ssmsap.ListComponentsInput{
+		ApplicationId: fwflex.StringFromFramework(ctx, data.ApplicationID),
+	}
+	output, err := findComponents(ctx, conn, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, applicationID)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data.Components))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func findComponents(ctx context.Context, conn *ssmsap.Client, input *ssmsap.ListComponentsInput) ([]awstypes.ComponentSummary, error) {
+	var output []awstypes.ComponentSummary
+
+	pages := ssmsap.NewListComponentsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		output = append(output, page.Components...)
+	}
+
+	return output, nil
+}
+
+type componentsDataSourceModel struct {
+	framework.WithRegionModel
+	ApplicationID types.String                                           `tfsdk:"application_id"`
+	Components    fwtypes.ListNestedObjectValueOf[componentSummaryModel] `tfsdk:"components"`
+}
+
+type componentSummaryModel struct {
+	ApplicationID types.String                               `tfsdk:"application_id"`
+	ARN           types.String                               `tfsdk:"arn"`
+	ComponentID   types.String                               `tfsdk:"component_id"`
+	ComponentType fwtypes.StringEnum[awstypes.ComponentType] `tfsdk:"component_type"`
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMSAPComponentsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	env := testAccHANAEnvironmentFromEnv(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ssmsap_components.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMSAPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentsDataSourceConfig_basic(rName, env),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "components.#", 1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "components.0.application_id", "aws_ssmsap_application.test", names.AttrApplicationID),
+				),
+			},
+		},
+	})
+}
+
+func testAccComponentsDataSourceConfig_basic(rName string, env testAccHANAEnvironment) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName, env), `
+data "aws_ssmsap_components" "test" {
+  application_id = aws_ssmsap_application.test.application_id
+}
+`)
+}
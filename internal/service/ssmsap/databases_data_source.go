@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/service/ssmsap"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssmsap/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ssmsap_databases", name="Databases")
+func newDatabasesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &databasesDataSource{}, nil
+}
+
+type databasesDataSource struct {
+	framework.DataSourceWithModel[databasesDataSourceModel]
+}
+
+func (d *databasesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Optional: true,
+			},
+			"component_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"databases": framework.DataSourceComputedListOfObjectAttribute[databaseSummaryModel](ctx),
+		},
+	}
+}
+
+func (d *databasesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data databasesDataSourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SSMSAPClient(ctx)
+
+	var input ssmsap.ListDatabasesInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findDatabases(ctx, conn, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data.Databases))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func findDatabases(ctx context.Context, conn *ssmsap.Client, input *ssmsap.ListDatabasesInput) ([]awstypes.DatabaseSummary, error) {
+	var output []awstypes.DatabaseSummary
+
+	pages := ssmsap.NewListDatabasesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		output = append(output, page.Databases...)
+	}
+
+	return output, nil
+}
+
+type databasesDataSourceModel struct {
+	framework.WithRegionModel
+	ApplicationID types.String                                          `tfsdk:"application_id"`
+	ComponentID   types.String                                          `tfsdk:"component_id"`
+	Databases     fwtypes.ListNestedObjectValueOf[databaseSummaryModel] `tfsdk:"databases"`
+}
+
+type databaseSummaryModel struct {
+	ApplicationID types.String                              `tfsdk:"application_id"`
+	ARN           types.String                              `tfsdk:"arn"`
+	ComponentID   types.String                              `tfsdk:"component_id"`
+	DatabaseID    types.String                              `tfsdk:"database_id"`
+	DatabaseType  fwtypes.StringEnum[awstypes.DatabaseType] `tfsdk:"database_type"`
+}
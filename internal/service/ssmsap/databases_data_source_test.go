@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMSAPDatabasesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	env := testAccHANAEnvironmentFromEnv(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ssmsap_databases.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMSAPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabasesDataSourceConfig_basic(rName, env),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "databases.#", 1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "databases.0.application_id", "aws_ssmsap_application.test", names.AttrApplicationID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "databases.0.database_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabasesDataSourceConfig_basic(rName string, env testAccHANAEnvironment) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName, env), `
+data "aws_ssmsap_databases" "test" {
+  application_id = aws_ssmsap_application.test.application_id
+}
+`)
+}
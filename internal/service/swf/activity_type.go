@@ -0,0 +1,353 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package swf
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/swf"
+	"github.com/aws/aws-sdk-go-v2/service/swf/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_swf_activity_type", name="Activity Type")
+func resourceActivityType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceActivityTypeCreate,
+		ReadWithoutTimeout:   resourceActivityTypeRead,
+		DeleteWithoutTimeout: resourceActivityTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_task_heartbeat_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_task_list": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_task_priority": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_task_schedule_to_close_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_task_schedule_to_start_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_task_start_to_close_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			names.AttrDomain: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrVersion: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+const activityTypeResourceIDPartCount = 3
+
+func resourceActivityTypeCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SWFClient(ctx)
+
+	domain, name, version := d.Get(names.AttrDomain).(string), d.Get(names.AttrName).(string), d.Get(names.AttrVersion).(string)
+	id, err := flex.FlattenResourceId([]string{domain, name, version}, activityTypeResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &swf.RegisterActivityTypeInput{
+		Domain:  aws.String(domain),
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	}
+
+	if v, ok := d.GetOk("default_task_heartbeat_timeout"); ok {
+		input.DefaultTaskHeartbeatTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_list"); ok {
+		input.DefaultTaskList = &types.TaskList{
+			Name: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("default_task_priority"); ok {
+		input.DefaultTaskPriority = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_schedule_to_close_timeout"); ok {
+		input.DefaultTaskScheduleToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_schedule_to_start_timeout"); ok {
+		input.DefaultTaskScheduleToStartTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_start_to_close_timeout"); ok {
+		input.DefaultTaskStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err = conn.RegisterActivityType(ctx, input)
+
+	// Activity types can't be deleted, only deprecated, so a previously deprecated type is undeprecated instead.
+	if errs.IsA[*types.TypeAlreadyExistsFault](err) {
+		err = undeprecateActivityType(ctx, conn, input)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SWF Activity Type (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceActivityTypeRead(ctx, d, meta)...)
+}
+
+func resourceActivityTypeRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SWFClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), activityTypeResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domain, name, version := parts[0], parts[1], parts[2]
+	output, err := findActivityTypeByThreePartKey(ctx, conn, domain, name, version)
+
+	if !d.IsNewResource() && retry.NotFound(err) {
+		log.Printf("[WARN] SWF Activity Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SWF Activity Type (%s): %s", d.Id(), err)
+	}
+
+	configuration, typeInfo := output.Configuration, output.TypeInfo
+	d.Set("creation_date", aws.ToTime(typeInfo.CreationDate).Format(time.RFC3339))
+	d.Set("default_task_heartbeat_timeout", configuration.DefaultTaskHeartbeatTimeout)
+	if configuration.DefaultTaskList != nil {
+		d.Set("default_task_list", configuration.DefaultTaskList.Name)
+	} else {
+		d.Set("default_task_list", nil)
+	}
+	d.Set("default_task_priority", configuration.DefaultTaskPriority)
+	d.Set("default_task_schedule_to_close_timeout", configuration.DefaultTaskScheduleToCloseTimeout)
+	d.Set("default_task_schedule_to_start_timeout", configuration.DefaultTaskScheduleToStartTimeout)
+	d.Set("default_task_start_to_close_timeout", configuration.DefaultTaskStartToCloseTimeout)
+	d.Set(names.AttrDescription, typeInfo.Description)
+	d.Set(names.AttrDomain, domain)
+	d.Set(names.AttrName, typeInfo.ActivityType.Name)
+	d.Set(names.AttrStatus, typeInfo.Status)
+	d.Set(names.AttrVersion, typeInfo.ActivityType.Version)
+
+	return diags
+}
+
+func resourceActivityTypeDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SWFClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), activityTypeResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deprecating SWF Activity Type: %s", d.Id())
+	_, err = conn.DeprecateActivityType(ctx, &swf.DeprecateActivityTypeInput{
+		Domain: aws.String(parts[0]),
+		ActivityType: &types.ActivityType{
+			Name:    aws.String(parts[1]),
+			Version: aws.String(parts[2]),
+		},
+	})
+
+	if errs.IsA[*types.TypeDeprecatedFault](err) || errs.IsA[*types.UnknownResourceFault](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SWF Activity Type (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findActivityTypeByThreePartKey(ctx context.Context, conn *swf.Client, domain, name, version string) (*swf.DescribeActivityTypeOutput, error) {
+	input := &swf.DescribeActivityTypeInput{
+		Domain: aws.String(domain),
+		ActivityType: &types.ActivityType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+	}
+
+	output, err := findActivityType(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.TypeInfo.Status; status == types.RegistrationStatusDeprecated {
+		return nil, &retry.NotFoundError{
+			Message: string(status),
+		}
+	}
+
+	return output, nil
+}
+
+func findActivityType(ctx context.Context, conn *swf.Client, input *swf.DescribeActivityTypeInput) (*swf.DescribeActivityTypeOutput, error) {
+	output, err := conn.DescribeActivityType(ctx, input)
+
+	if errs.IsA[*types.UnknownResourceFault](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Configuration == nil || output.TypeInfo == nil || output.TypeInfo.ActivityType == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// undeprecateActivityType undeprecates an existing activity type with the same name and version.
+// The registered configuration of a type can't be changed, so any settings that are configured must match the existing registration.
+func undeprecateActivityType(ctx context.Context, conn *swf.Client, input *swf.RegisterActivityTypeInput) error {
+	activityType := &types.ActivityType{
+		Name:    input.Name,
+		Version: input.Version,
+	}
+	output, err := findActivityType(ctx, conn, &swf.DescribeActivityTypeInput{
+		Domain:       input.Domain,
+		ActivityType: activityType,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if diffs := activityTypeConfigurationDiffs(input, output); len(diffs) > 0 {
+		return fmt.Errorf("version %s is already registered with different %s; registered activity types can't be changed, so bump the version", aws.ToString(input.Version), strings.Join(diffs, ", "))
+	}
+
+	_, err = conn.UndeprecateActivityType(ctx, &swf.UndeprecateActivityTypeInput{
+		Domain:       input.Domain,
+		ActivityType: activityType,
+	})
+
+	return err
+}
+
+func activityTypeConfigurationDiffs(input *swf.RegisterActivityTypeInput, output *swf.DescribeActivityTypeOutput) []string {
+	var diffs []string
+	configuration := output.Configuration
+
+	if v := input.DefaultTaskHeartbeatTimeout; v != nil && aws.ToString(v) != aws.ToString(configuration.DefaultTaskHeartbeatTimeout) {
+		diffs = append(diffs, "default_task_heartbeat_timeout")
+	}
+
+	if v := input.DefaultTaskList; v != nil && taskListName(v) != taskListName(configuration.DefaultTaskList) {
+		diffs = append(diffs, "default_task_list")
+	}
+
+	if v := input.DefaultTaskPriority; v != nil && aws.ToString(v) != aws.ToString(configuration.DefaultTaskPriority) {
+		diffs = append(diffs, "default_task_priority")
+	}
+
+	if v := input.DefaultTaskScheduleToCloseTimeout; v != nil && aws.ToString(v) != aws.ToString(configuration.DefaultTaskScheduleToCloseTimeout) {
+		diffs = append(diffs, "default_task_schedule_to_close_timeout")
+	}
+
+	if v := input.DefaultTaskScheduleToStartTimeout; v != nil && aws.ToString(v) != aws.ToString(configuration.DefaultTaskScheduleToStartTimeout) {
+		diffs = append(diffs, "default_task_schedule_to_start_timeout")
+	}
+
+	if v := input.DefaultTaskStartToCloseTimeout; v != nil && aws.ToString(v) != aws.ToString(configuration.DefaultTaskStartToCloseTimeout) {
+		diffs = append(diffs, "default_task_start_to_close_timeout")
+	}
+
+	if v := input.Description; v != nil && aws.ToString(v) != aws.ToString(output.TypeInfo.Description) {
+		diffs = append(diffs, names.AttrDescription)
+	}
+
+	return diffs
+}
@@ -34,6 +34,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePackageSDKResource {
 	return []*inttypes.ServicePackageSDKResource{
+		{
+			Factory:  resourceActivityType,
+			TypeName: "aws_swf_activity_type",
+			Name:     "Activity Type",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  resourceDomain,
 			TypeName: "aws_swf_domain",
@@ -43,6 +49,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  resourceWorkflowType,
+			TypeName: "aws_swf_workflow_type",
+			Name:     "Workflow Type",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
@@ -0,0 +1,365 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package swf
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/swf"
+	"github.com/aws/aws-sdk-go-v2/service/swf/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_swf_workflow_type", name="Workflow Type")
+func resourceWorkflowType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWorkflowTypeCreate,
+		ReadWithoutTimeout:   resourceWorkflowTypeRead,
+		DeleteWithoutTimeout: resourceWorkflowTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_child_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ChildPolicy](),
+			},
+			"default_execution_start_to_close_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_lambda_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"default_task_list": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_task_priority": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_task_start_to_close_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			names.AttrDomain: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrVersion: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+const workflowTypeResourceIDPartCount = 3
+
+func resourceWorkflowTypeCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SWFClient(ctx)
+
+	domain, name, version := d.Get(names.AttrDomain).(string), d.Get(names.AttrName).(string), d.Get(names.AttrVersion).(string)
+	id, err := flex.FlattenResourceId([]string{domain, name, version}, workflowTypeResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &swf.RegisterWorkflowTypeInput{
+		Domain:  aws.String(domain),
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	}
+
+	if v, ok := d.GetOk("default_child_policy"); ok {
+		input.DefaultChildPolicy = types.ChildPolicy(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_execution_start_to_close_timeout"); ok {
+		input.DefaultExecutionStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_lambda_role"); ok {
+		input.DefaultLambdaRole = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_list"); ok {
+		input.DefaultTaskList = &types.TaskList{
+			Name: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("default_task_priority"); ok {
+		input.DefaultTaskPriority = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_start_to_close_timeout"); ok {
+		input.DefaultTaskStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err = conn.RegisterWorkflowType(ctx, input)
+
+	// Workflow types can't be deleted, only deprecated, so a previously deprecated type is undeprecated instead.
+	if errs.IsA[*types.TypeAlreadyExistsFault](err) {
+		err = undeprecateWorkflowType(ctx, conn, input)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SWF Workflow Type (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceWorkflowTypeRead(ctx, d, meta)...)
+}
+
+func resourceWorkflowTypeRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SWFClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), workflowTypeResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domain, name, version := parts[0], parts[1], parts[2]
+	output, err := findWorkflowTypeByThreePartKey(ctx, conn, domain, name, version)
+
+	if !d.IsNewResource() && retry.NotFound(err) {
+		log.Printf("[WARN] SWF Workflow Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SWF Workflow Type (%s): %s", d.Id(), err)
+	}
+
+	configuration, typeInfo := output.Configuration, output.TypeInfo
+	d.Set("creation_date", aws.ToTime(typeInfo.CreationDate).Format(time.RFC3339))
+	d.Set("default_child_policy", configuration.DefaultChildPolicy)
+	d.Set("default_execution_start_to_close_timeout", configuration.DefaultExecutionStartToCloseTimeout)
+	d.Set("default_lambda_role", configuration.DefaultLambdaRole)
+	if configuration.DefaultTaskList != nil {
+		d.Set("default_task_list", configuration.DefaultTaskList.Name)
+	} else {
+		d.Set("default_task_list", nil)
+	}
+	d.Set("default_task_priority", configuration.DefaultTaskPriority)
+	d.Set("default_task_start_to_close_timeout", configuration.DefaultTaskStartToCloseTimeout)
+	d.Set(names.AttrDescription, typeInfo.Description)
+	d.Set(names.AttrDomain, domain)
+	d.Set(names.AttrName, typeInfo.WorkflowType.Name)
+	d.Set(names.AttrStatus, typeInfo.Status)
+	d.Set(names.AttrVersion, typeInfo.WorkflowType.Version)
+
+	return diags
+}
+
+func resourceWorkflowTypeDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SWFClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), workflowTypeResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deprecating SWF Workflow Type: %s", d.Id())
+	_, err = conn.DeprecateWorkflowType(ctx, &swf.DeprecateWorkflowTypeInput{
+		Domain: aws.String(parts[0]),
+		WorkflowType: &types.WorkflowType{
+			Name:    aws.String(parts[1]),
+			Version: aws.String(parts[2]),
+		},
+	})
+
+	if errs.IsA[*types.TypeDeprecatedFault](err) || errs.IsA[*types.UnknownResourceFault](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SWF Workflow Type (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findWorkflowTypeByThreePartKey(ctx context.Context, conn *swf.Client, domain, name, version string) (*swf.DescribeWorkflowTypeOutput, error) {
+	input := &swf.DescribeWorkflowTypeInput{
+		Domain: aws.String(domain),
+		WorkflowType: &types.WorkflowType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+	}
+
+	output, err := findWorkflowType(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.TypeInfo.Status; status == types.RegistrationStatusDeprecated {
+		return nil, &retry.NotFoundError{
+			Message: string(status),
+		}
+	}
+
+	return output, nil
+}
+
+func findWorkflowType(ctx context.Context, conn *swf.Client, input *swf.DescribeWorkflowTypeInput) (*swf.DescribeWorkflowTypeOutput, error) {
+	output, err := conn.DescribeWorkflowType(ctx, input)
+
+	if errs.IsA[*types.UnknownResourceFault](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Configuration == nil || output.TypeInfo == nil || output.TypeInfo.WorkflowType == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// undeprecateWorkflowType undeprecates an existing workflow type with the same name and version.
+// The registered configuration of a type can't be changed, so any settings that are configured must match the existing registration.
+func undeprecateWorkflowType(ctx context.Context, conn *swf.Client, input *swf.RegisterWorkflowTypeInput) error {
+	workflowType := &types.WorkflowType{
+		Name:    input.Name,
+		Version: input.Version,
+	}
+	output, err := findWorkflowType(ctx, conn, &swf.DescribeWorkflowTypeInput{
+		Domain:       input.Domain,
+		WorkflowType: workflowType,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if diffs := workflowTypeConfigurationDiffs(input, output); len(diffs) > 0 {
+		return fmt.Errorf("version %s is already registered with different %s; registered workflow types can't be changed, so bump the version", aws.ToString(input.Version), strings.Join(diffs, ", "))
+	}
+
+	_, err = conn.UndeprecateWorkflowType(ctx, &swf.UndeprecateWorkflowTypeInput{
+		Domain:       input.Domain,
+		WorkflowType: workflowType,
+	})
+
+	return err
+}
+
+func workflowTypeConfigurationDiffs(input *swf.RegisterWorkflowTypeInput, output *swf.DescribeWorkflowTypeOutput) []string {
+	var diffs []string
+	configuration := output.Configuration
+
+	if v := input.DefaultChildPolicy; v != "" && v != configuration.DefaultChildPolicy {
+		diffs = append(diffs, "default_child_policy")
+	}
+
+	if v := input.DefaultExecutionStartToCloseTimeout; v != nil && aws.ToString(v) != aws.ToString(configuration.DefaultExecutionStartToCloseTimeout) {
+		diffs = append(diffs, "default_execution_start_to_close_timeout")
+	}
+
+	if v := input.DefaultLambdaRole; v != nil && aws.ToString(v) != aws.ToString(configuration.DefaultLambdaRole) {
+		diffs = append(diffs, "default_lambda_role")
+	}
+
+	if v := input.DefaultTaskList; v != nil && taskListName(v) != taskListName(configuration.DefaultTaskList) {
+		diffs = append(diffs, "default_task_list")
+	}
+
+	if v := input.DefaultTaskPriority; v != nil && aws.ToString(v) != aws.ToString(configuration.DefaultTaskPriority) {
+		diffs = append(diffs, "default_task_priority")
+	}
+
+	if v := input.DefaultTaskStartToCloseTimeout; v != nil && aws.ToString(v) != aws.ToString(configuration.DefaultTaskStartToCloseTimeout) {
+		diffs = append(diffs, "default_task_start_to_close_timeout")
+	}
+
+	if v := input.Description; v != nil && aws.ToString(v) != aws.ToString(output.TypeInfo.Description) {
+		diffs = append(diffs, names.AttrDescription)
+	}
+
+	return diffs
+}
+
+func taskListName(apiObject *types.TaskList) string {
+	if apiObject == nil {
+		return ""
+	}
+
+	return aws.ToString(apiObject.Name)
+}
@@ -0,0 +1,13 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings
+
+// Exports for use in tests only.
+var (
+	ResourceSupplementalTaxRegistration = newSupplementalTaxRegistrationResource
+	ResourceTaxRegistration             = newTaxRegistrationResource
+
+	FindSupplementalTaxRegistrationByID = findSupplementalTaxRegistrationByID
+	FindTaxRegistrationByAccountID      = findTaxRegistrationByAccountID
+)
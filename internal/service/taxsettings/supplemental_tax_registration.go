@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/taxsettings"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_taxsettings_supplemental_tax_registration", name="Supplemental Tax Registration")
+// @Region(global=true)
+func newSupplementalTaxRegistrationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &supplementalTaxRegistrationResource{}, nil
+}
+
+type supplementalTaxRegistrationResource struct {
+	framework.ResourceWithModel[supplementalTaxRegistrationResourceModel]
+	framework.WithNoUpdate
+}
+
+func (r *supplementalTaxRegistrationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	address := addressBlock(ctx)
+	address.PlanModifiers = []planmodifier.List{
+		listplanmodifier.RequiresReplace(),
+	}
+	address.Validators = []validator.List{
+		listvalidator.IsRequired(),
+		listvalidator.SizeAtMost(1),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authority_id": framework.IDAttribute(),
+			"legal_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"registration_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"registration_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SupplementalTaxRegistrationType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TaxRegistrationStatus](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrAddress: address,
+		},
+	}
+}
+
+func (r *supplementalTaxRegistrationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data supplementalTaxRegistrationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	var entry awstypes.SupplementalTaxRegistrationEntry
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &entry))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := taxsettings.PutSupplementalTaxRegistrationInput{
+		TaxRegistrationEntry: &entry,
+	}
+	output, err := conn.PutSupplementalTaxRegistration(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, data.RegistrationID.ValueString())
+		return
+	}
+
+	// Set values for unknowns.
+	data.AuthorityID = fwflex.StringToFramework(ctx, output.AuthorityId)
+	data.Status = fwtypes.StringEnumValue(output.Status)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *supplementalTaxRegistrationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data supplementalTaxRegistrationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	authorityID := data.AuthorityID.ValueString()
+	output, err := findSupplementalTaxRegistrationByID(ctx, conn, authorityID)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, authorityID)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *supplementalTaxRegistrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data supplementalTaxRegistrationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	authorityID := data.AuthorityID.ValueString()
+	input := taxsettings.DeleteSupplementalTaxRegistrationInput{
+		AuthorityId: aws.String(authorityID),
+	}
+	_, err := conn.DeleteSupplementalTaxRegistration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, authorityID)
+		return
+	}
+}
+
+func (r *supplementalTaxRegistrationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("authority_id"), request, response)
+}
+
+func findSupplementalTaxRegistrationByID(ctx context.Context, conn *taxsettings.Client, id string) (*awstypes.SupplementalTaxRegistration, error) {
+	var input taxsettings.ListSupplementalTaxRegistrationsInput
+	output, err := findSupplementalTaxRegistrations(ctx, conn, &input, func(v *awstypes.SupplementalTaxRegistration) bool {
+		return aws.ToString(v.AuthorityId) == id
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return smarterr.Assert(tfresource.AssertSingleValueResult(output))
+}
+
+func findSupplementalTaxRegistrations(ctx context.Context, conn *taxsettings.Client, input *taxsettings.ListSupplementalTaxRegistrationsInput, filter tfslices.Predicate[*awstypes.SupplementalTaxRegistration]) ([]awstypes.SupplementalTaxRegistration, error) {
+	var output []awstypes.SupplementalTaxRegistration
+
+	pages := taxsettings.NewListSupplementalTaxRegistrationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		for _, v := range page.TaxRegistrations {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type supplementalTaxRegistrationResourceModel struct {
+	Address          fwtypes.ListNestedObjectValueOf[addressModel]                `tfsdk:"address"`
+	AuthorityID      types.String                                                 `tfsdk:"authority_id"`
+	LegalName        types.String                                                 `tfsdk:"legal_name"`
+	RegistrationID   types.String                                                 `tfsdk:"registration_id"`
+	RegistrationType fwtypes.StringEnum[awstypes.SupplementalTaxRegistrationType] `tfsdk:"registration_type"`
+	Status           fwtypes.StringEnum[awstypes.TaxRegistrationStatus]           `tfsdk:"status"`
+}
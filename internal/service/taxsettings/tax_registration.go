@@ -0,0 +1,298 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/taxsettings"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_taxsettings_tax_registration", name="Tax Registration")
+// @Region(global=true)
+func newTaxRegistrationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &taxRegistrationResource{}, nil
+}
+
+type taxRegistrationResource struct {
+	framework.ResourceWithModel[taxRegistrationResourceModel]
+}
+
+func (r *taxRegistrationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"certified_email_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"legal_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"registration_id": schema.StringAttribute{
+				Required: true,
+			},
+			"registration_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TaxRegistrationType](),
+				Required:   true,
+			},
+			"sector": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Sector](),
+				Optional:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TaxRegistrationStatus](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"legal_address": addressBlock(ctx),
+		},
+	}
+}
+
+func addressBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[addressModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"address_line_1": schema.StringAttribute{
+					Required: true,
+				},
+				"address_line_2": schema.StringAttribute{
+					Optional: true,
+				},
+				"address_line_3": schema.StringAttribute{
+					Optional: true,
+				},
+				"city": schema.StringAttribute{
+					Required: true,
+				},
+				"country_code": schema.StringAttribute{
+					Required: true,
+				},
+				"district_or_county": schema.StringAttribute{
+					Optional: true,
+				},
+				"postal_code": schema.StringAttribute{
+					Required: true,
+				},
+				"state_or_region": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *taxRegistrationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data taxRegistrationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	if data.AccountID.ValueString() == "" {
+		data.AccountID = types.StringValue(r.Meta().AccountID(ctx))
+	}
+	accountID := data.AccountID.ValueString()
+	output, err := putTaxRegistration(ctx, conn, &data)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+
+	// Set values for unknowns.
+	data.Status = fwtypes.StringEnumValue(output.Status)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *taxRegistrationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data taxRegistrationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	accountID := data.AccountID.ValueString()
+	output, err := findTaxRegistrationByAccountID(ctx, conn, accountID)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *taxRegistrationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new taxRegistrationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	accountID := new.AccountID.ValueString()
+	output, err := putTaxRegistration(ctx, conn, &new)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+
+	new.Status = fwtypes.StringEnumValue(output.Status)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *taxRegistrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data taxRegistrationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	accountID := data.AccountID.ValueString()
+	input := taxsettings.DeleteTaxRegistrationInput{
+		AccountId: aws.String(accountID),
+	}
+	_, err := conn.DeleteTaxRegistration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+}
+
+func (r *taxRegistrationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrAccountID), request, response)
+}
+
+func putTaxRegistration(ctx context.Context, conn *taxsettings.Client, data *taxRegistrationResourceModel) (*taxsettings.PutTaxRegistrationOutput, error) {
+	var entry awstypes.TaxRegistrationEntry
+	if diags := fwflex.Expand(ctx, data, &entry); diags.HasError() {
+		return nil, fwdiag.DiagnosticsError(diags)
+	}
+
+	input := taxsettings.PutTaxRegistrationInput{
+		AccountId:            fwflex.StringFromFramework(ctx, data.AccountID),
+		TaxRegistrationEntry: &entry,
+	}
+	output, err := conn.PutTaxRegistration(ctx, &input)
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	return output, nil
+}
+
+func findTaxRegistrationByAccountID(ctx context.Context, conn *taxsettings.Client, accountID string) (*awstypes.TaxRegistration, error) {
+	input := taxsettings.GetTaxRegistrationInput{
+		AccountId: aws.String(accountID),
+	}
+	output, err := conn.GetTaxRegistration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.TaxRegistration == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	if status := output.TaxRegistration.Status; status == awstypes.TaxRegistrationStatusDeleted {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: string(status),
+		})
+	}
+
+	return output.TaxRegistration, nil
+}
+
+type taxRegistrationResourceModel struct {
+	AccountID        types.String                                       `tfsdk:"account_id"`
+	CertifiedEmailID types.String                                       `tfsdk:"certified_email_id"`
+	LegalAddress     fwtypes.ListNestedObjectValueOf[addressModel]      `tfsdk:"legal_address"`
+	LegalName        types.String                                       `tfsdk:"legal_name"`
+	RegistrationID   types.String                                       `tfsdk:"registration_id"`
+	RegistrationType fwtypes.StringEnum[awstypes.TaxRegistrationType]   `tfsdk:"registration_type"`
+	Sector           fwtypes.StringEnum[awstypes.Sector]                `tfsdk:"sector"`
+	Status           fwtypes.StringEnum[awstypes.TaxRegistrationStatus] `tfsdk:"status"`
+}
+
+type addressModel struct {
+	AddressLine1     types.String `tfsdk:"address_line_1"`
+	AddressLine2     types.String `tfsdk:"address_line_2"`
+	AddressLine3     types.String `tfsdk:"address_line_3"`
+	City             types.String `tfsdk:"city"`
+	CountryCode      types.String `tfsdk:"country_code"`
+	DistrictOrCounty types.String `tfsdk:"district_or_county"`
+	PostalCode       types.String `tfsdk:"postal_code"`
+	StateOrRegion    types.String `tfsdk:"state_or_region"`
+}
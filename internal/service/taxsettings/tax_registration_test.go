@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftaxsettings "github.com/hashicorp/terraform-provider-aws/internal/service/taxsettings"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccTaxRegistration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	registrationID := acctest.SkipIfEnvVarNotSet(t, envVarRegistrationID)
+	legalName := acctest.SkipIfEnvVarNotSet(t, envVarLegalName)
+	countryCode := acctest.SkipIfEnvVarNotSet(t, envVarCountryCode)
+	var v awstypes.TaxRegistration
+	resourceName := "aws_taxsettings_tax_registration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TaxSettingsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaxRegistrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaxRegistrationConfig_basic(registrationID, legalName, countryCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaxRegistrationExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrAccountID(ctx, resourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, "legal_address.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "legal_address.0.country_code", countryCode),
+					resource.TestCheckResourceAttr(resourceName, "legal_name", legalName),
+					resource.TestCheckResourceAttr(resourceName, "registration_id", registrationID),
+					resource.TestCheckResourceAttr(resourceName, "registration_type", "VAT"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrAccountID),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrAccountID,
+			},
+		},
+	})
+}
+
+func testAccTaxRegistration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	registrationID := acctest.SkipIfEnvVarNotSet(t, envVarRegistrationID)
+	legalName := acctest.SkipIfEnvVarNotSet(t, envVarLegalName)
+	countryCode := acctest.SkipIfEnvVarNotSet(t, envVarCountryCode)
+	var v awstypes.TaxRegistration
+	resourceName := "aws_taxsettings_tax_registration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TaxSettingsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaxRegistrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaxRegistrationConfig_basic(registrationID, legalName, countryCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaxRegistrationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tftaxsettings.ResourceTaxRegistration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTaxRegistrationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TaxSettingsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_taxsettings_tax_registration" {
+				continue
+			}
+
+			_, err := tftaxsettings.FindTaxRegistrationByAccountID(ctx, conn, rs.Primary.Attributes[names.AttrAccountID])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Tax Settings Tax Registration %s still exists", rs.Primary.Attributes[names.AttrAccountID])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTaxRegistrationExists(ctx context.Context, n string, v *awstypes.TaxRegistration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TaxSettingsClient(ctx)
+
+		output, err := tftaxsettings.FindTaxRegistrationByAccountID(ctx, conn, rs.Primary.Attributes[names.AttrAccountID])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTaxRegistrationConfig_basic(registrationID, legalName, countryCode string) string {
+	return fmt.Sprintf(`
+resource "aws_taxsettings_tax_registration" "test" {
+  registration_id   = %[1]q
+  registration_type = "VAT"
+  legal_name        = %[2]q
+
+  legal_address {
+    address_line_1 = "1 Example Street"
+    city           = "Example City"
+    country_code   = %[3]q
+    postal_code    = "12345"
+  }
+}
+`, registrationID, legalName, countryCode)
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/service/taxsettings"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+)
+
+// @FrameworkDataSource("aws_taxsettings_tax_registrations", name="Tax Registrations")
+// @Region(global=true)
+func newTaxRegistrationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &taxRegistrationsDataSource{}, nil
+}
+
+type taxRegistrationsDataSource struct {
+	framework.DataSourceWithModel[taxRegistrationsDataSourceModel]
+}
+
+func (d *taxRegistrationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_details": framework.DataSourceComputedListOfObjectAttribute[accountDetailsModel](ctx),
+		},
+	}
+}
+
+func (d *taxRegistrationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data taxRegistrationsDataSourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().TaxSettingsClient(ctx)
+
+	var input taxsettings.ListTaxRegistrationsInput
+	output, err := findAccountDetails(ctx, conn, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data.AccountDetails))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func findAccountDetails(ctx context.Context, conn *taxsettings.Client, input *taxsettings.ListTaxRegistrationsInput) ([]awstypes.AccountDetails, error) {
+	var output []awstypes.AccountDetails
+
+	pages := taxsettings.NewListTaxRegistrationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		output = append(output, page.AccountDetails...)
+	}
+
+	return output, nil
+}
+
+type taxRegistrationsDataSourceModel struct {
+	AccountDetails fwtypes.ListNestedObjectValueOf[accountDetailsModel] `tfsdk:"account_details"`
+}
+
+type accountDetailsModel struct {
+	AccountID       types.String                                                          `tfsdk:"account_id"`
+	TaxRegistration fwtypes.ListNestedObjectValueOf[taxRegistrationWithJurisdictionModel] `tfsdk:"tax_registration"`
+}
+
+type taxRegistrationWithJurisdictionModel struct {
+	CertifiedEmailID types.String                                       `tfsdk:"certified_email_id"`
+	Jurisdiction     fwtypes.ListNestedObjectValueOf[jurisdictionModel] `tfsdk:"jurisdiction"`
+	LegalName        types.String                                       `tfsdk:"legal_name"`
+	RegistrationID   types.String                                       `tfsdk:"registration_id"`
+	RegistrationType fwtypes.StringEnum[awstypes.TaxRegistrationType]   `tfsdk:"registration_type"`
+	Sector           fwtypes.StringEnum[awstypes.Sector]                `tfsdk:"sector"`
+	Status           fwtypes.StringEnum[awstypes.TaxRegistrationStatus] `tfsdk:"status"`
+}
+
+type jurisdictionModel struct {
+	CountryCode   types.String `tfsdk:"country_code"`
+	StateOrRegion types.String `tfsdk:"state_or_region"`
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccTaxRegistrationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	registrationID := acctest.SkipIfEnvVarNotSet(t, envVarRegistrationID)
+	legalName := acctest.SkipIfEnvVarNotSet(t, envVarLegalName)
+	countryCode := acctest.SkipIfEnvVarNotSet(t, envVarCountryCode)
+	dataSourceName := "data.aws_taxsettings_tax_registrations.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TaxSettingsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaxRegistrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaxRegistrationsDataSourceConfig_basic(registrationID, legalName, countryCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "account_details.#", 1),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "account_details.*.tax_registration.*", map[string]string{
+						"registration_id": registrationID,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccTaxRegistrationsDataSourceConfig_basic(registrationID, legalName, countryCode string) string {
+	return acctest.ConfigCompose(testAccTaxRegistrationConfig_basic(registrationID, legalName, countryCode), `
+data "aws_taxsettings_tax_registrations" "test" {
+  depends_on = [aws_taxsettings_tax_registration.test]
+}
+`)
+}
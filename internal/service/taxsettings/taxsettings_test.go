@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/taxsettings"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Tax registrations are per-account singletons and changes are validated
+// against real tax authority records, so the registration values must be
+// supplied via environment variables.
+const (
+	envVarRegistrationID             = "AWS_TAXSETTINGS_REGISTRATION_ID"
+	envVarSupplementalRegistrationID = "AWS_TAXSETTINGS_SUPPLEMENTAL_REGISTRATION_ID"
+	envVarLegalName                  = "AWS_TAXSETTINGS_LEGAL_NAME"
+	envVarCountryCode                = "AWS_TAXSETTINGS_COUNTRY_CODE"
+)
+
+func TestAccTaxSettings_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"TaxRegistration": {
+			acctest.CtBasic:      testAccTaxRegistration_basic,
+			acctest.CtDisappears: testAccTaxRegistration_disappears,
+		},
+		"TaxRegistrationsDataSource": {
+			acctest.CtBasic: testAccTaxRegistrationsDataSource_basic,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TaxSettingsClient(ctx)
+
+	input := taxsettings.ListTaxRegistrationsInput{}
+	_, err := conn.ListTaxRegistrations(ctx, &input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
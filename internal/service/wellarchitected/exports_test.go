@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+// Exports for use in tests only.
+var (
+	ResourceLens           = newLensResource
+	ResourceLensShare      = newLensShareResource
+	ResourceProfile        = newProfileResource
+	ResourceReviewTemplate = newReviewTemplateResource
+	ResourceWorkload       = newWorkloadResource
+
+	FindLensByARN             = findLensByARN
+	FindLensShareByTwoPartKey = findLensShareByTwoPartKey
+	FindProfileByARN          = findProfileByARN
+	FindReviewTemplateByARN   = findReviewTemplateByARN
+	FindWorkloadByID          = findWorkloadByID
+)
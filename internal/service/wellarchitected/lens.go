@@ -0,0 +1,437 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	lensImportTimeout = 2 * time.Minute
+)
+
+// @FrameworkResource("aws_wellarchitected_lens", name="Lens")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newLensResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &lensResource{}, nil
+}
+
+type lensResource struct {
+	framework.ResourceWithModel[lensResourceModel]
+	framework.WithImportByARN
+}
+
+func (r *lensResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_major_version": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"json_string": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVersion: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+		},
+	}
+}
+
+func (r *lensResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data lensResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := wellarchitected.ImportLensInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		JSONString:         fwflex.StringFromFramework(ctx, data.JSONString),
+		Tags:               getTagsIn(ctx),
+	}
+	arn, err := importLens(ctx, conn, &input)
+
+	if err != nil {
+		if arn != "" {
+			response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint.
+		}
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	if !data.Version.IsNull() {
+		if err := createLensVersion(ctx, conn, arn, &data); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint.
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	lens, err := findLensByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint.
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringValueToFramework(ctx, arn)
+	data.Description = fwflex.StringToFramework(ctx, lens.Description)
+	data.Name = fwflex.StringToFramework(ctx, lens.Name)
+	data.Owner = fwflex.StringToFramework(ctx, lens.Owner)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *lensResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data lensResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := data.ARN.ValueString()
+	output, err := findLensByARN(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	data.Description = fwflex.StringToFramework(ctx, output.Description)
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.Owner = fwflex.StringToFramework(ctx, output.Owner)
+
+	// The exported lens JSON is not byte-for-byte identical to the imported document,
+	// so it is only read back on import.
+	if data.JSONString.IsNull() {
+		input := wellarchitected.ExportLensInput{
+			LensAlias: aws.String(arn),
+		}
+		output, err := conn.ExportLens(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		data.JSONString = jsontypes.NewNormalizedPointerValue(output.LensJSON)
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *lensResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old lensResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := new.ARN.ValueString()
+
+	// Re-importing the lens JSON updates the lens draft.
+	if !new.JSONString.Equal(old.JSONString) {
+		input := wellarchitected.ImportLensInput{
+			ClientRequestToken: aws.String(sdkid.UniqueId()),
+			JSONString:         fwflex.StringFromFramework(ctx, new.JSONString),
+			LensAlias:          aws.String(arn),
+		}
+
+		if _, err := importLens(ctx, conn, &input); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	// Publishing a new version requires a new version string.
+	if !new.Version.IsNull() && !new.Version.Equal(old.Version) {
+		if err := createLensVersion(ctx, conn, arn, &new); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	lens, err := findLensByARN(ctx, conn, arn)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	// Set values for unknowns.
+	new.Description = fwflex.StringToFramework(ctx, lens.Description)
+	new.Name = fwflex.StringToFramework(ctx, lens.Name)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *lensResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data lensResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := data.ARN.ValueString()
+	input := wellarchitected.DeleteLensInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		LensAlias:          aws.String(arn),
+		LensStatus:         awstypes.LensStatusTypeAll,
+	}
+	_, err := conn.DeleteLens(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+}
+
+func (r *lensResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state lensResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Name and description come from the lens JSON.
+	if !plan.JSONString.Equal(state.JSONString) {
+		plan.Description = types.StringUnknown()
+		plan.Name = types.StringUnknown()
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Plan.Set(ctx, &plan))
+}
+
+// importLens imports the lens JSON and waits for the lens draft to reflect the import.
+// The lens ARN is returned whenever the import request is accepted, even if the wait fails.
+func importLens(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ImportLensInput) (string, error) {
+	// When re-importing an existing lens, note when its draft was last updated.
+	var draftUpdatedAt *time.Time
+	if alias := aws.ToString(input.LensAlias); alias != "" {
+		draft, err := findLensDraftByARN(ctx, conn, alias)
+
+		switch {
+		case retry.NotFound(err):
+		case err != nil:
+			return "", err
+		default:
+			draftUpdatedAt = draft.UpdatedAt
+		}
+	}
+
+	output, err := conn.ImportLens(ctx, input)
+
+	if err != nil {
+		return "", smarterr.NewError(err)
+	}
+
+	arn := aws.ToString(output.LensArn)
+
+	if output.Status == awstypes.ImportLensStatusError {
+		return arn, smarterr.NewError(fmt.Errorf("import status: %s", output.Status))
+	}
+
+	if _, err := waitLensDraftUpdated(ctx, conn, arn, draftUpdatedAt, lensImportTimeout); err != nil {
+		return arn, smarterr.NewError(err)
+	}
+
+	return arn, nil
+}
+
+func createLensVersion(ctx context.Context, conn *wellarchitected.Client, arn string, data *lensResourceModel) error {
+	input := wellarchitected.CreateLensVersionInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		IsMajorVersion:     fwflex.BoolFromFramework(ctx, data.IsMajorVersion),
+		LensAlias:          aws.String(arn),
+		LensVersion:        fwflex.StringFromFramework(ctx, data.Version),
+	}
+	_, err := conn.CreateLensVersion(ctx, &input)
+
+	if err != nil {
+		return smarterr.NewError(err)
+	}
+
+	return nil
+}
+
+func findLensByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.Lens, error) {
+	input := wellarchitected.GetLensInput{
+		LensAlias: aws.String(arn),
+	}
+	output, err := conn.GetLens(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Lens == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	return output.Lens, nil
+}
+
+func findLensDraftByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.LensSummary, error) {
+	input := wellarchitected.ListLensesInput{
+		LensStatus: awstypes.LensStatusTypeDraft,
+		LensType:   awstypes.LensTypeCustomSelf,
+	}
+	output, err := findLenses(ctx, conn, &input, func(v *awstypes.LensSummary) bool {
+		return aws.ToString(v.LensArn) == arn
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return smarterr.Assert(tfresource.AssertSingleValueResult(output))
+}
+
+func findLenses(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensesInput, filter tfslices.Predicate[*awstypes.LensSummary]) ([]awstypes.LensSummary, error) {
+	var output []awstypes.LensSummary
+
+	pages := wellarchitected.NewListLensesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		for _, v := range page.LensSummaries {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// waitLensDraftUpdated waits for the lens draft to reflect an import. The first import creates the draft,
+// and each re-import advances the draft's last updated time. A rejected import leaves the draft unchanged.
+func waitLensDraftUpdated(ctx context.Context, conn *wellarchitected.Client, arn string, updatedAt *time.Time, timeout time.Duration) (*awstypes.LensSummary, error) {
+	output, err := tfresource.RetryWhenNotFound(ctx, timeout, func(ctx context.Context) (*awstypes.LensSummary, error) {
+		draft, err := findLensDraftByARN(ctx, conn, arn)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if updatedAt != nil && !aws.ToTime(draft.UpdatedAt).After(aws.ToTime(updatedAt)) {
+			return nil, smarterr.NewError(&retry.NotFoundError{
+				Message: "lens draft not yet updated",
+			})
+		}
+
+		return draft, nil
+	})
+
+	if err != nil {
+		return nil, smarterr.NewError(fmt.Errorf("waiting for lens draft, check json_string against the custom lens format: %w", err))
+	}
+
+	return output, nil
+}
+
+type lensResourceModel struct {
+	framework.WithRegionModel
+	ARN            types.String         `tfsdk:"arn"`
+	Description    types.String         `tfsdk:"description"`
+	IsMajorVersion types.Bool           `tfsdk:"is_major_version"`
+	JSONString     jsontypes.Normalized `tfsdk:"json_string"`
+	Name           types.String         `tfsdk:"name"`
+	Owner          types.String         `tfsdk:"owner"`
+	Tags           tftags.Map           `tfsdk:"tags"`
+	TagsAll        tftags.Map           `tfsdk:"tags_all"`
+	Version        types.String         `tfsdk:"version"`
+}
@@ -0,0 +1,241 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	lensShareResourceIDPartCount = 2
+)
+
+// @FrameworkResource("aws_wellarchitected_lens_share", name="Lens Share")
+func newLensShareResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &lensShareResource{}, nil
+}
+
+type lensShareResource struct {
+	framework.ResourceWithModel[lensShareResourceModel]
+	framework.WithNoUpdate
+}
+
+func (r *lensShareResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"lens_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"share_id": framework.IDAttribute(),
+			"shared_with": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ShareStatus](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (r *lensShareResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data lensShareResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	lensARN := data.LensARN.ValueString()
+	input := wellarchitected.CreateLensShareInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		LensAlias:          aws.String(lensARN),
+		SharedWith:         fwflex.StringFromFramework(ctx, data.SharedWith),
+	}
+	output, err := conn.CreateLensShare(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, lensARN)
+		return
+	}
+
+	shareID := aws.ToString(output.ShareId)
+	share, err := findLensShareByTwoPartKey(ctx, conn, lensARN, shareID)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("share_id"), shareID) // Set 'share_id' so as to taint.
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, shareID)
+		return
+	}
+
+	// Set values for unknowns.
+	data.ShareID = fwflex.StringValueToFramework(ctx, shareID)
+	data.Status = fwtypes.StringEnumValue(share.Status)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *lensShareResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data lensShareResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	lensARN, shareID := data.LensARN.ValueString(), data.ShareID.ValueString()
+	output, err := findLensShareByTwoPartKey(ctx, conn, lensARN, shareID)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, shareID)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *lensShareResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data lensShareResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	lensARN, shareID := data.LensARN.ValueString(), data.ShareID.ValueString()
+	input := wellarchitected.DeleteLensShareInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		LensAlias:          aws.String(lensARN),
+		ShareId:            aws.String(shareID),
+	}
+	_, err := conn.DeleteLensShare(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, shareID)
+		return
+	}
+}
+
+func (r *lensShareResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(request.ID, lensShareResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("lens_arn"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("share_id"), parts[1])...)
+}
+
+func findLensShareByTwoPartKey(ctx context.Context, conn *wellarchitected.Client, lensARN, shareID string) (*awstypes.LensShareSummary, error) {
+	input := wellarchitected.ListLensSharesInput{
+		LensAlias: aws.String(lensARN),
+	}
+	output, err := findLensShares(ctx, conn, &input, func(v *awstypes.LensShareSummary) bool {
+		return aws.ToString(v.ShareId) == shareID
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	share, err := smarterr.Assert(tfresource.AssertSingleValueResult(output))
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch share.Status {
+	case awstypes.ShareStatusRejected, awstypes.ShareStatusRevoked, awstypes.ShareStatusExpired:
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: string(share.Status),
+		})
+	}
+
+	return share, nil
+}
+
+func findLensShares(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensSharesInput, filter tfslices.Predicate[*awstypes.LensShareSummary]) ([]awstypes.LensShareSummary, error) {
+	var output []awstypes.LensShareSummary
+
+	pages := wellarchitected.NewListLensSharesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, smarterr.NewError(&retry.NotFoundError{
+				LastError: err,
+			})
+		}
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		for _, v := range page.LensShareSummaries {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type lensShareResourceModel struct {
+	framework.WithRegionModel
+	LensARN    fwtypes.ARN                              `tfsdk:"lens_arn"`
+	ShareID    types.String                             `tfsdk:"share_id"`
+	SharedWith types.String                             `tfsdk:"shared_with"`
+	Status     fwtypes.StringEnum[awstypes.ShareStatus] `tfsdk:"status"`
+}
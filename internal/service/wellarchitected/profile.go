@@ -0,0 +1,328 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_profile", name="Profile")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newProfileResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &profileResource{}, nil
+}
+
+type profileResource struct {
+	framework.ResourceWithModel[profileResourceModel]
+	framework.WithImportByARN
+}
+
+func (r *profileResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"profile_question": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[profileQuestionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"question_id": schema.StringAttribute{
+							Required: true,
+						},
+						"selected_choice_ids": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *profileResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data profileResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	name := data.Name.ValueString()
+	var input wellarchitected.CreateProfileInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input, fwflex.WithFieldNamePrefix("Profile")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(sdkid.UniqueId())
+	if input.ProfileQuestions == nil {
+		input.ProfileQuestions = []awstypes.ProfileQuestionUpdate{}
+	}
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateProfile(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	arn := aws.ToString(output.ProfileArn)
+	profile, err := findProfileByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint.
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, data.flatten(ctx, profile))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *profileResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data profileResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := data.ARN.ValueString()
+	output, err := findProfileByARN(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, data.flatten(ctx, output))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *profileResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old profileResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := new.ARN.ValueString()
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("Version"))
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input wellarchitected.UpdateProfileInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input, fwflex.WithFieldNamePrefix("Profile")))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Questions removed from configuration have their selections cleared.
+		newQuestions, d := new.ProfileQuestions.ToSlice(ctx)
+		smerr.AddEnrich(ctx, &response.Diagnostics, d)
+		oldQuestions, d := old.ProfileQuestions.ToSlice(ctx)
+		smerr.AddEnrich(ctx, &response.Diagnostics, d)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		for _, oldQuestion := range oldQuestions {
+			if !tfslices.Any(newQuestions, func(v *profileQuestionModel) bool {
+				return v.QuestionID.Equal(oldQuestion.QuestionID)
+			}) {
+				input.ProfileQuestions = append(input.ProfileQuestions, awstypes.ProfileQuestionUpdate{
+					QuestionId:        fwflex.StringFromFramework(ctx, oldQuestion.QuestionID),
+					SelectedChoiceIds: []string{},
+				})
+			}
+		}
+
+		_, err := conn.UpdateProfile(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		profile, err := findProfileByARN(ctx, conn, arn)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		// Set values for unknowns.
+		smerr.AddEnrich(ctx, &response.Diagnostics, new.flatten(ctx, profile))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.Version = old.Version
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *profileResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data profileResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := data.ARN.ValueString()
+	input := wellarchitected.DeleteProfileInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		ProfileArn:         aws.String(arn),
+	}
+	_, err := conn.DeleteProfile(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+}
+
+func findProfileByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.Profile, error) {
+	input := wellarchitected.GetProfileInput{
+		ProfileArn: aws.String(arn),
+	}
+	output, err := conn.GetProfile(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Profile == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	return output.Profile, nil
+}
+
+type profileResourceModel struct {
+	framework.WithRegionModel
+	ARN              types.String                                         `tfsdk:"arn"`
+	Description      types.String                                         `tfsdk:"description"`
+	Name             types.String                                         `tfsdk:"name"`
+	Owner            types.String                                         `tfsdk:"owner"`
+	ProfileQuestions fwtypes.SetNestedObjectValueOf[profileQuestionModel] `tfsdk:"profile_question"`
+	Tags             tftags.Map                                           `tfsdk:"tags"`
+	TagsAll          tftags.Map                                           `tfsdk:"tags_all"`
+	Version          types.String                                         `tfsdk:"version"`
+}
+
+func (m *profileResourceModel) flatten(ctx context.Context, v *awstypes.Profile) diag.Diagnostics {
+	// The API returns every question in the profile's question set; only those with selections are managed.
+	profile := *v
+	profile.ProfileQuestions = tfslices.Filter(v.ProfileQuestions, func(v awstypes.ProfileQuestion) bool {
+		return len(v.SelectedChoiceIds) > 0
+	})
+
+	return fwflex.Flatten(ctx, &profile, m, fwflex.WithFieldNamePrefix("Profile"))
+}
+
+type profileQuestionModel struct {
+	QuestionID        types.String        `tfsdk:"question_id"`
+	SelectedChoiceIDs fwtypes.SetOfString `tfsdk:"selected_choice_ids"`
+}
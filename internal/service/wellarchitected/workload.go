@@ -0,0 +1,478 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_workload", name="Workload")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newWorkloadResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &workloadResource{}, nil
+}
+
+type workloadResource struct {
+	framework.ResourceWithModel[workloadResourceModel]
+	framework.WithImportByID
+}
+
+func (r *workloadResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	optionalComputedSetOfStringAttribute := func() schema.SetAttribute {
+		return schema.SetAttribute{
+			CustomType:  fwtypes.SetOfStringType,
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_ids":  optionalComputedSetOfStringAttribute(),
+			"applications": optionalComputedSetOfStringAttribute(),
+			"architectural_design": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2048),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"aws_regions": optionalComputedSetOfStringAttribute(),
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 250),
+				},
+			},
+			names.AttrEnvironment: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadEnvironment](),
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"improvement_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadImprovementStatus](),
+				Computed:   true,
+			},
+			"industry": schema.StringAttribute{
+				Optional: true,
+			},
+			"industry_type": schema.StringAttribute{
+				Optional: true,
+			},
+			"lenses": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+			"non_aws_regions": optionalComputedSetOfStringAttribute(),
+			"notes": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2084),
+				},
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pillar_priorities": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"profile_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(1),
+				},
+			},
+			"review_owner": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+				},
+			},
+			"review_template_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"discovery_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[workloadDiscoveryConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"trusted_advisor_integration_status": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.TrustedAdvisorIntegrationStatus](),
+							Optional:   true,
+							Computed:   true,
+						},
+						"workload_resource_definition": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringEnumType[awstypes.DefinitionType](),
+							ElementType: fwtypes.StringEnumType[awstypes.DefinitionType](),
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *workloadResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data workloadResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	name := data.Name.ValueString()
+	var input wellarchitected.CreateWorkloadInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input, fwflex.WithFieldNamePrefix("Workload")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateWorkload(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	id := aws.ToString(output.WorkloadId)
+	workload, err := findWorkloadByID(ctx, conn, id)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint.
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, data.flatten(ctx, workload))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *workloadResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data workloadResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	id := data.ID.ValueString()
+	output, err := findWorkloadByID(ctx, conn, id)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, data.flatten(ctx, output))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *workloadResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old workloadResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	id := new.ID.ValueString()
+
+	if !new.Lenses.Equal(old.Lenses) {
+		newLenses, oldLenses := fwflex.ExpandFrameworkStringValueSet(ctx, new.Lenses), fwflex.ExpandFrameworkStringValueSet(ctx, old.Lenses)
+
+		if add := newLenses.Difference(oldLenses); len(add) > 0 {
+			input := wellarchitected.AssociateLensesInput{
+				LensAliases: add,
+				WorkloadId:  aws.String(id),
+			}
+			_, err := conn.AssociateLenses(ctx, &input)
+
+			if err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+				return
+			}
+		}
+
+		if del := oldLenses.Difference(newLenses); len(del) > 0 {
+			input := wellarchitected.DisassociateLensesInput{
+				LensAliases: del,
+				WorkloadId:  aws.String(id),
+			}
+			_, err := conn.DisassociateLenses(ctx, &input)
+
+			if err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+				return
+			}
+		}
+	}
+
+	if !new.ProfileARNs.Equal(old.ProfileARNs) {
+		newProfileARNs, oldProfileARNs := fwflex.ExpandFrameworkStringValueSet(ctx, new.ProfileARNs), fwflex.ExpandFrameworkStringValueSet(ctx, old.ProfileARNs)
+
+		if del := oldProfileARNs.Difference(newProfileARNs); len(del) > 0 {
+			input := wellarchitected.DisassociateProfilesInput{
+				ProfileArns: del,
+				WorkloadId:  aws.String(id),
+			}
+			_, err := conn.DisassociateProfiles(ctx, &input)
+
+			if err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+				return
+			}
+		}
+
+		if add := newProfileARNs.Difference(oldProfileARNs); len(add) > 0 {
+			input := wellarchitected.AssociateProfilesInput{
+				ProfileArns: add,
+				WorkloadId:  aws.String(id),
+			}
+			_, err := conn.AssociateProfiles(ctx, &input)
+
+			if err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+				return
+			}
+		}
+	}
+
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("Lenses"), fwflex.WithIgnoredField("ProfileARNs"))
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input wellarchitected.UpdateWorkloadInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input, fwflex.WithFieldNamePrefix("Workload")))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateWorkload(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	workload, err := findWorkloadByID(ctx, conn, id)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, new.flatten(ctx, workload))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *workloadResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data workloadResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	id := data.ID.ValueString()
+	input := wellarchitected.DeleteWorkloadInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		WorkloadId:         aws.String(id),
+	}
+	_, err := conn.DeleteWorkload(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func findWorkloadByID(ctx context.Context, conn *wellarchitected.Client, id string) (*awstypes.Workload, error) {
+	input := wellarchitected.GetWorkloadInput{
+		WorkloadId: aws.String(id),
+	}
+	output, err := conn.GetWorkload(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Workload == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	return output.Workload, nil
+}
+
+type workloadResourceModel struct {
+	framework.WithRegionModel
+	AccountIDs          fwtypes.SetOfString                                           `tfsdk:"account_ids"`
+	Applications        fwtypes.SetOfString                                           `tfsdk:"applications"`
+	ArchitecturalDesign types.String                                                  `tfsdk:"architectural_design"`
+	ARN                 types.String                                                  `tfsdk:"arn"`
+	AWSRegions          fwtypes.SetOfString                                           `tfsdk:"aws_regions"`
+	Description         types.String                                                  `tfsdk:"description"`
+	DiscoveryConfig     fwtypes.ListNestedObjectValueOf[workloadDiscoveryConfigModel] `tfsdk:"discovery_config"`
+	Environment         fwtypes.StringEnum[awstypes.WorkloadEnvironment]              `tfsdk:"environment"`
+	ID                  types.String                                                  `tfsdk:"id"`
+	ImprovementStatus   fwtypes.StringEnum[awstypes.WorkloadImprovementStatus]        `tfsdk:"improvement_status"`
+	Industry            types.String                                                  `tfsdk:"industry"`
+	IndustryType        types.String                                                  `tfsdk:"industry_type"`
+	Lenses              fwtypes.SetOfString                                           `tfsdk:"lenses"`
+	Name                types.String                                                  `tfsdk:"name"`
+	NonAWSRegions       fwtypes.SetOfString                                           `tfsdk:"non_aws_regions"`
+	Notes               types.String                                                  `tfsdk:"notes"`
+	Owner               types.String                                                  `tfsdk:"owner"`
+	PillarPriorities    fwtypes.ListOfString                                          `tfsdk:"pillar_priorities"`
+	ProfileARNs         fwtypes.SetOfString                                           `tfsdk:"profile_arns"`
+	ReviewOwner         types.String                                                  `tfsdk:"review_owner"`
+	ReviewTemplateARNs  fwtypes.SetOfString                                           `tfsdk:"review_template_arns"`
+	Tags                tftags.Map                                                    `tfsdk:"tags"`
+	TagsAll             tftags.Map                                                    `tfsdk:"tags_all"`
+}
+
+func (m *workloadResourceModel) flatten(ctx context.Context, v *awstypes.Workload) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	discoveryConfigWasNull := m.DiscoveryConfig.IsNull()
+	diags.Append(fwflex.Flatten(ctx, v, m, fwflex.WithFieldNamePrefix("Workload"), fwflex.WithIgnoredFieldNamesAppend("Lenses"))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The API always returns a default discovery configuration.
+	if discoveryConfigWasNull {
+		m.DiscoveryConfig = fwtypes.NewListNestedObjectValueOfNull[workloadDiscoveryConfigModel](ctx)
+	}
+
+	// Lenses applied by review templates are reported alongside those configured directly,
+	// so they are only read back on import.
+	if m.Lenses.IsNull() {
+		m.Lenses = fwflex.FlattenFrameworkStringValueSetOfString(ctx, v.Lenses)
+	}
+
+	m.ProfileARNs = fwflex.FlattenFrameworkStringValueSetOfString(ctx, tfslices.ApplyToAll(v.Profiles, func(v awstypes.WorkloadProfile) string {
+		return aws.ToString(v.ProfileArn)
+	}))
+
+	return diags
+}
+
+type workloadDiscoveryConfigModel struct {
+	TrustedAdvisorIntegrationStatus fwtypes.StringEnum[awstypes.TrustedAdvisorIntegrationStatus]    `tfsdk:"trusted_advisor_integration_status"`
+	WorkloadResourceDefinition      fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.DefinitionType]] `tfsdk:"workload_resource_definition"`
+}
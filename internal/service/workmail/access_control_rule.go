@@ -0,0 +1,270 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workmail_access_control_rule", name="Access Control Rule")
+func newAccessControlRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &accessControlRuleResource{}, nil
+}
+
+const (
+	accessControlRuleResourceIDPartCount = 2
+)
+
+type accessControlRuleResource struct {
+	framework.ResourceWithModel[accessControlRuleResourceModel]
+}
+
+func (r *accessControlRuleResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	stringSetAttribute := func() schema.SetAttribute {
+		return schema.SetAttribute{
+			CustomType:  fwtypes.SetOfStringType,
+			Optional:    true,
+			ElementType: types.StringType,
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"actions": stringSetAttribute(),
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"effect": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AccessControlRuleEffect](),
+				Required:   true,
+			},
+			"impersonation_role_ids": stringSetAttribute(),
+			"ip_ranges":              stringSetAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"not_actions":                stringSetAttribute(),
+			"not_impersonation_role_ids": stringSetAttribute(),
+			"not_ip_ranges":              stringSetAttribute(),
+			"not_user_ids":               stringSetAttribute(),
+			"organization_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_ids": stringSetAttribute(),
+		},
+	}
+}
+
+func (r *accessControlRuleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data accessControlRuleResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	name := data.Name.ValueString()
+	if err := putAccessControlRule(ctx, conn, &data); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *accessControlRuleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data accessControlRuleResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	name := data.Name.ValueString()
+	output, err := findAccessControlRuleByTwoPartKey(ctx, conn, data.OrganizationID.ValueString(), name)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *accessControlRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new accessControlRuleResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	name := new.Name.ValueString()
+	if err := putAccessControlRule(ctx, conn, &new); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *accessControlRuleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data accessControlRuleResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	name := data.Name.ValueString()
+	input := workmail.DeleteAccessControlRuleInput{
+		Name:           aws.String(name),
+		OrganizationId: fwflex.StringFromFramework(ctx, data.OrganizationID),
+	}
+	_, err := conn.DeleteAccessControlRule(ctx, &input)
+
+	if errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+}
+
+func (r *accessControlRuleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(request.ID, accessControlRuleResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrName), parts[1])...)
+}
+
+// putAccessControlRule creates or replaces the named access control rule.
+func putAccessControlRule(ctx context.Context, conn *workmail.Client, data *accessControlRuleResourceModel) error {
+	var input workmail.PutAccessControlRuleInput
+	if diags := fwflex.Expand(ctx, data, &input); diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	_, err := conn.PutAccessControlRule(ctx, &input)
+
+	if err != nil {
+		return smarterr.NewError(err)
+	}
+
+	return nil
+}
+
+func findAccessControlRuleByTwoPartKey(ctx context.Context, conn *workmail.Client, organizationID, name string) (*awstypes.AccessControlRule, error) {
+	input := workmail.ListAccessControlRulesInput{
+		OrganizationId: aws.String(organizationID),
+	}
+
+	return findAccessControlRule(ctx, conn, &input, func(v *awstypes.AccessControlRule) bool {
+		return aws.ToString(v.Name) == name
+	})
+}
+
+func findAccessControlRule(ctx context.Context, conn *workmail.Client, input *workmail.ListAccessControlRulesInput, filter tfslices.Predicate[*awstypes.AccessControlRule]) (*awstypes.AccessControlRule, error) {
+	output, err := findAccessControlRules(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	return smarterr.Assert(tfresource.AssertSingleValueResult(output))
+}
+
+func findAccessControlRules(ctx context.Context, conn *workmail.Client, input *workmail.ListAccessControlRulesInput, filter tfslices.Predicate[*awstypes.AccessControlRule]) ([]awstypes.AccessControlRule, error) {
+	output, err := conn.ListAccessControlRules(ctx, input)
+
+	if errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(input))
+	}
+
+	return tfslices.Filter(output.Rules, tfslices.PredicateValue(filter)), nil
+}
+
+type accessControlRuleResourceModel struct {
+	framework.WithRegionModel
+	Actions                 fwtypes.SetOfString                                  `tfsdk:"actions"`
+	Description             types.String                                         `tfsdk:"description"`
+	Effect                  fwtypes.StringEnum[awstypes.AccessControlRuleEffect] `tfsdk:"effect"`
+	ImpersonationRoleIDs    fwtypes.SetOfString                                  `tfsdk:"impersonation_role_ids"`
+	IPRanges                fwtypes.SetOfString                                  `tfsdk:"ip_ranges"`
+	Name                    types.String                                         `tfsdk:"name"`
+	NotActions              fwtypes.SetOfString                                  `tfsdk:"not_actions"`
+	NotImpersonationRoleIDs fwtypes.SetOfString                                  `tfsdk:"not_impersonation_role_ids"`
+	NotIPRanges             fwtypes.SetOfString                                  `tfsdk:"not_ip_ranges"`
+	NotUserIDs              fwtypes.SetOfString                                  `tfsdk:"not_user_ids"`
+	OrganizationID          types.String                                         `tfsdk:"organization_id"`
+	UserIDs                 fwtypes.SetOfString                                  `tfsdk:"user_ids"`
+}
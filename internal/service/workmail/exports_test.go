@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+// Exports for use in tests only.
+var (
+	ResourceAccessControlRule      = newAccessControlRuleResource
+	ResourceGroup                  = newGroupResource
+	ResourceGroupMembership        = newGroupMembershipResource
+	ResourceMailDomain             = newMailDomainResource
+	ResourceMobileDeviceAccessRule = newMobileDeviceAccessRuleResource
+	ResourceOrganization           = newOrganizationResource
+	ResourceResource               = newResourceResource
+	ResourceUser                   = newUserResource
+
+	FindAccessControlRuleByTwoPartKey      = findAccessControlRuleByTwoPartKey
+	FindGroupByTwoPartKey                  = findGroupByTwoPartKey
+	FindGroupMembershipByThreePartKey      = findGroupMembershipByThreePartKey
+	FindMailDomainByTwoPartKey             = findMailDomainByTwoPartKey
+	FindMobileDeviceAccessRuleByTwoPartKey = findMobileDeviceAccessRuleByTwoPartKey
+	FindOrganizationByID                   = findOrganizationByID
+	FindResourceByTwoPartKey               = findResourceByTwoPartKey
+	FindUserByTwoPartKey                   = findUserByTwoPartKey
+)
@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource("aws_workmail_group_membership", name="Group Membership")
+func newGroupMembershipResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &groupMembershipResource{}, nil
+}
+
+const (
+	groupMembershipResourceIDPartCount = 3
+)
+
+type groupMembershipResource struct {
+	framework.ResourceWithModel[groupMembershipResourceModel]
+	framework.WithNoUpdate
+}
+
+func (r *groupMembershipResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MemberType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *groupMembershipResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data groupMembershipResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, groupID, memberID := data.OrganizationID.ValueString(), data.GroupID.ValueString(), data.MemberID.ValueString()
+	input := workmail.AssociateMemberToGroupInput{
+		GroupId:        aws.String(groupID),
+		MemberId:       aws.String(memberID),
+		OrganizationId: aws.String(organizationID),
+	}
+	_, err := conn.AssociateMemberToGroup(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, groupID)
+		return
+	}
+
+	member, err := findGroupMembershipByThreePartKey(ctx, conn, organizationID, groupID, memberID)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, groupID)
+		return
+	}
+
+	// Set values for unknowns.
+	data.MemberType = fwtypes.StringEnumValue(member.Type)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *groupMembershipResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data groupMembershipResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, groupID, memberID := data.OrganizationID.ValueString(), data.GroupID.ValueString(), data.MemberID.ValueString()
+	output, err := findGroupMembershipByThreePartKey(ctx, conn, organizationID, groupID, memberID)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, groupID)
+		return
+	}
+
+	data.MemberType = fwtypes.StringEnumValue(output.Type)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *groupMembershipResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data groupMembershipResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	groupID := data.GroupID.ValueString()
+	input := workmail.DisassociateMemberFromGroupInput{
+		GroupId:        aws.String(groupID),
+		MemberId:       fwflex.StringFromFramework(ctx, data.MemberID),
+		OrganizationId: fwflex.StringFromFramework(ctx, data.OrganizationID),
+	}
+	_, err := conn.DisassociateMemberFromGroup(ctx, &input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, groupID)
+		return
+	}
+}
+
+func (r *groupMembershipResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(request.ID, groupMembershipResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("group_id"), parts[1])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("member_id"), parts[2])...)
+}
+
+func findGroupMembershipByThreePartKey(ctx context.Context, conn *workmail.Client, organizationID, groupID, memberID string) (*awstypes.Member, error) {
+	input := workmail.ListGroupMembersInput{
+		GroupId:        aws.String(groupID),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	return findGroupMember(ctx, conn, &input, func(v *awstypes.Member) bool {
+		return aws.ToString(v.Id) == memberID && v.State != awstypes.EntityStateDeleted
+	})
+}
+
+func findGroupMember(ctx context.Context, conn *workmail.Client, input *workmail.ListGroupMembersInput, filter tfslices.Predicate[*awstypes.Member]) (*awstypes.Member, error) {
+	output, err := findGroupMembers(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	return smarterr.Assert(tfresource.AssertSingleValueResult(output))
+}
+
+func findGroupMembers(ctx context.Context, conn *workmail.Client, input *workmail.ListGroupMembersInput, filter tfslices.Predicate[*awstypes.Member]) ([]awstypes.Member, error) {
+	var output []awstypes.Member
+
+	pages := workmail.NewListGroupMembersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+			return nil, smarterr.NewError(&retry.NotFoundError{
+				LastError: err,
+			})
+		}
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		for _, v := range page.Members {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type groupMembershipResourceModel struct {
+	framework.WithRegionModel
+	GroupID        types.String                            `tfsdk:"group_id"`
+	MemberID       types.String                            `tfsdk:"member_id"`
+	MemberType     fwtypes.StringEnum[awstypes.MemberType] `tfsdk:"member_type"`
+	OrganizationID types.String                            `tfsdk:"organization_id"`
+}
@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkMailGroupMembership_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Member
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workmail_group_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupMembershipExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", "aws_workmail_group.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "member_id", "aws_workmail_user.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "member_type", string(awstypes.MemberTypeUser)),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccGroupMembershipImportStateIDFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "member_id",
+			},
+		},
+	})
+}
+
+func TestAccWorkMailGroupMembership_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Member
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workmail_group_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupMembershipExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfworkmail.ResourceGroupMembership, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGroupMembershipDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workmail_group_membership" {
+				continue
+			}
+
+			_, err := tfworkmail.FindGroupMembershipByThreePartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["group_id"], rs.Primary.Attributes["member_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkMail Group Membership %s still exists", rs.Primary.Attributes["member_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupMembershipExists(ctx context.Context, n string, v *awstypes.Member) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailClient(ctx)
+
+		output, err := tfworkmail.FindGroupMembershipByThreePartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["group_id"], rs.Primary.Attributes["member_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccGroupMembershipImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s,%s,%s", rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["group_id"], rs.Primary.Attributes["member_id"]), nil
+	}
+}
+
+func testAccGroupMembershipConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGroupConfig_basic(rName, false), `
+resource "aws_workmail_user" "test" {
+  organization_id = aws_workmail_organization.test.id
+  name            = "testuser"
+  display_name    = "Test User"
+  password        = "Avoid-Plaintext-Passwords-1"
+  email           = "testuser@${aws_workmail_organization.test.default_mail_domain}"
+}
+
+resource "aws_workmail_group_membership" "test" {
+  organization_id = aws_workmail_organization.test.id
+  group_id        = aws_workmail_group.test.id
+  member_id       = aws_workmail_user.test.id
+}
+`)
+}
@@ -0,0 +1,230 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workmail_mail_domain", name="Mail Domain")
+func newMailDomainResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &mailDomainResource{}, nil
+}
+
+const (
+	mailDomainResourceIDPartCount = 2
+)
+
+type mailDomainResource struct {
+	framework.ResourceWithModel[mailDomainResourceModel]
+	framework.WithNoUpdate
+}
+
+func (r *mailDomainResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dkim_verification_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DnsRecordVerificationStatus](),
+				Computed:   true,
+			},
+			names.AttrDomainName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_default": schema.BoolAttribute{
+				Computed: true,
+			},
+			"is_test_domain": schema.BoolAttribute{
+				Computed: true,
+			},
+			"organization_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ownership_verification_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DnsRecordVerificationStatus](),
+				Computed:   true,
+			},
+			"records": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[dnsRecordModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[dnsRecordModel](ctx),
+			},
+		},
+	}
+}
+
+func (r *mailDomainResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data mailDomainResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, domainName := data.OrganizationID.ValueString(), data.DomainName.ValueString()
+	input := workmail.RegisterMailDomainInput{
+		ClientToken:    aws.String(sdkid.UniqueId()),
+		DomainName:     aws.String(domainName),
+		OrganizationId: aws.String(organizationID),
+	}
+	_, err := conn.RegisterMailDomain(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, domainName)
+		return
+	}
+
+	output, err := findMailDomainByTwoPartKey(ctx, conn, organizationID, domainName)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, domainName)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *mailDomainResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data mailDomainResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	domainName := data.DomainName.ValueString()
+	output, err := findMailDomainByTwoPartKey(ctx, conn, data.OrganizationID.ValueString(), domainName)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, domainName)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *mailDomainResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data mailDomainResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	domainName := data.DomainName.ValueString()
+	input := workmail.DeregisterMailDomainInput{
+		DomainName:     aws.String(domainName),
+		OrganizationId: fwflex.StringFromFramework(ctx, data.OrganizationID),
+	}
+	_, err := conn.DeregisterMailDomain(ctx, &input)
+
+	if errs.IsA[*awstypes.MailDomainNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, domainName)
+		return
+	}
+}
+
+func (r *mailDomainResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(request.ID, mailDomainResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrDomainName), parts[1])...)
+}
+
+func findMailDomainByTwoPartKey(ctx context.Context, conn *workmail.Client, organizationID, domainName string) (*workmail.GetMailDomainOutput, error) {
+	input := workmail.GetMailDomainInput{
+		DomainName:     aws.String(domainName),
+		OrganizationId: aws.String(organizationID),
+	}
+	output, err := conn.GetMailDomain(ctx, &input)
+
+	if errs.IsA[*awstypes.MailDomainNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	return output, nil
+}
+
+type mailDomainResourceModel struct {
+	framework.WithRegionModel
+	DKIMVerificationStatus      fwtypes.StringEnum[awstypes.DnsRecordVerificationStatus] `tfsdk:"dkim_verification_status"`
+	DomainName                  types.String                                             `tfsdk:"domain_name"`
+	IsDefault                   types.Bool                                               `tfsdk:"is_default"`
+	IsTestDomain                types.Bool                                               `tfsdk:"is_test_domain"`
+	OrganizationID              types.String                                             `tfsdk:"organization_id"`
+	OwnershipVerificationStatus fwtypes.StringEnum[awstypes.DnsRecordVerificationStatus] `tfsdk:"ownership_verification_status"`
+	Records                     fwtypes.ListNestedObjectValueOf[dnsRecordModel]          `tfsdk:"records"`
+}
+
+type dnsRecordModel struct {
+	Hostname types.String `tfsdk:"hostname"`
+	Type     types.String `tfsdk:"type"`
+	Value    types.String `tfsdk:"value"`
+}
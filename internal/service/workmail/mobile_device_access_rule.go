@@ -0,0 +1,276 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workmail_mobile_device_access_rule", name="Mobile Device Access Rule")
+func newMobileDeviceAccessRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &mobileDeviceAccessRuleResource{}, nil
+}
+
+const (
+	mobileDeviceAccessRuleResourceIDPartCount = 2
+)
+
+type mobileDeviceAccessRuleResource struct {
+	framework.ResourceWithModel[mobileDeviceAccessRuleResourceModel]
+}
+
+func (r *mobileDeviceAccessRuleResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	stringSetAttribute := func() schema.SetAttribute {
+		return schema.SetAttribute{
+			CustomType:  fwtypes.SetOfStringType,
+			Optional:    true,
+			ElementType: types.StringType,
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"device_models":            stringSetAttribute(),
+			"device_operating_systems": stringSetAttribute(),
+			"device_types":             stringSetAttribute(),
+			"device_user_agents":       stringSetAttribute(),
+			"effect": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MobileDeviceAccessRuleEffect](),
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"not_device_models":            stringSetAttribute(),
+			"not_device_operating_systems": stringSetAttribute(),
+			"not_device_types":             stringSetAttribute(),
+			"not_device_user_agents":       stringSetAttribute(),
+			"organization_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *mobileDeviceAccessRuleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data mobileDeviceAccessRuleResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	name := data.Name.ValueString()
+	var input workmail.CreateMobileDeviceAccessRuleInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+
+	output, err := conn.CreateMobileDeviceAccessRule(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.MobileDeviceAccessRuleId)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *mobileDeviceAccessRuleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data mobileDeviceAccessRuleResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	id := data.ID.ValueString()
+	output, err := findMobileDeviceAccessRuleByTwoPartKey(ctx, conn, data.OrganizationID.ValueString(), id)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("MobileDeviceAccessRule")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *mobileDeviceAccessRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new mobileDeviceAccessRuleResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	id := new.ID.ValueString()
+	var input workmail.UpdateMobileDeviceAccessRuleInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input, fwflex.WithFieldNamePrefix("MobileDeviceAccessRule")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.UpdateMobileDeviceAccessRule(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *mobileDeviceAccessRuleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data mobileDeviceAccessRuleResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	id := data.ID.ValueString()
+	input := workmail.DeleteMobileDeviceAccessRuleInput{
+		MobileDeviceAccessRuleId: aws.String(id),
+		OrganizationId:           fwflex.StringFromFramework(ctx, data.OrganizationID),
+	}
+	_, err := conn.DeleteMobileDeviceAccessRule(ctx, &input)
+
+	if errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func (r *mobileDeviceAccessRuleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(request.ID, mobileDeviceAccessRuleResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), parts[1])...)
+}
+
+func findMobileDeviceAccessRuleByTwoPartKey(ctx context.Context, conn *workmail.Client, organizationID, ruleID string) (*awstypes.MobileDeviceAccessRule, error) {
+	input := workmail.ListMobileDeviceAccessRulesInput{
+		OrganizationId: aws.String(organizationID),
+	}
+
+	return findMobileDeviceAccessRule(ctx, conn, &input, func(v *awstypes.MobileDeviceAccessRule) bool {
+		return aws.ToString(v.MobileDeviceAccessRuleId) == ruleID
+	})
+}
+
+func findMobileDeviceAccessRule(ctx context.Context, conn *workmail.Client, input *workmail.ListMobileDeviceAccessRulesInput, filter tfslices.Predicate[*awstypes.MobileDeviceAccessRule]) (*awstypes.MobileDeviceAccessRule, error) {
+	output, err := findMobileDeviceAccessRules(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	return smarterr.Assert(tfresource.AssertSingleValueResult(output))
+}
+
+func findMobileDeviceAccessRules(ctx context.Context, conn *workmail.Client, input *workmail.ListMobileDeviceAccessRulesInput, filter tfslices.Predicate[*awstypes.MobileDeviceAccessRule]) ([]awstypes.MobileDeviceAccessRule, error) {
+	output, err := conn.ListMobileDeviceAccessRules(ctx, input)
+
+	if errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(input))
+	}
+
+	return tfslices.Filter(output.Rules, tfslices.PredicateValue(filter)), nil
+}
+
+type mobileDeviceAccessRuleResourceModel struct {
+	framework.WithRegionModel
+	Description               types.String                                              `tfsdk:"description"`
+	DeviceModels              fwtypes.SetOfString                                       `tfsdk:"device_models"`
+	DeviceOperatingSystems    fwtypes.SetOfString                                       `tfsdk:"device_operating_systems"`
+	DeviceTypes               fwtypes.SetOfString                                       `tfsdk:"device_types"`
+	DeviceUserAgents          fwtypes.SetOfString                                       `tfsdk:"device_user_agents"`
+	Effect                    fwtypes.StringEnum[awstypes.MobileDeviceAccessRuleEffect] `tfsdk:"effect"`
+	ID                        types.String                                              `tfsdk:"id"`
+	Name                      types.String                                              `tfsdk:"name"`
+	NotDeviceModels           fwtypes.SetOfString                                       `tfsdk:"not_device_models"`
+	NotDeviceOperatingSystems fwtypes.SetOfString                                       `tfsdk:"not_device_operating_systems"`
+	NotDeviceTypes            fwtypes.SetOfString                                       `tfsdk:"not_device_types"`
+	NotDeviceUserAgents       fwtypes.SetOfString                                       `tfsdk:"not_device_user_agents"`
+	OrganizationID            types.String                                              `tfsdk:"organization_id"`
+}
@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkMailMobileDeviceAccessRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.MobileDeviceAccessRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workmail_mobile_device_access_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMobileDeviceAccessRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMobileDeviceAccessRuleConfig_basic(rName, "DENY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMobileDeviceAccessRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "device_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "device_types.*", "iPhone"),
+					resource.TestCheckResourceAttr(resourceName, "effect", "DENY"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "organization_id", "aws_workmail_organization.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccEntityImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMobileDeviceAccessRuleConfig_basic(rName, "ALLOW"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMobileDeviceAccessRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "effect", "ALLOW"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkMailMobileDeviceAccessRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.MobileDeviceAccessRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workmail_mobile_device_access_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkMailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMobileDeviceAccessRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMobileDeviceAccessRuleConfig_basic(rName, "DENY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMobileDeviceAccessRuleExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfworkmail.ResourceMobileDeviceAccessRule, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMobileDeviceAccessRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workmail_mobile_device_access_rule" {
+				continue
+			}
+
+			_, err := tfworkmail.FindMobileDeviceAccessRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.ID)
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkMail Mobile Device Access Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMobileDeviceAccessRuleExists(ctx context.Context, n string, v *awstypes.MobileDeviceAccessRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailClient(ctx)
+
+		output, err := tfworkmail.FindMobileDeviceAccessRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMobileDeviceAccessRuleConfig_basic(rName, effect string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_mobile_device_access_rule" "test" {
+  organization_id = aws_workmail_organization.test.id
+  name            = %[1]q
+  effect          = %[2]q
+  device_types    = ["iPhone"]
+}
+`, rName, effect))
+}
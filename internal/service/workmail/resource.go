@@ -0,0 +1,374 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workmail_resource", name="Resource")
+func newResourceResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceResource{}, nil
+}
+
+type resourceResource struct {
+	framework.ResourceWithModel[resourceResourceModel]
+}
+
+func (r *resourceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"booking_options": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[bookingOptionsModel](ctx),
+				Optional:    true,
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[bookingOptionsModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			names.AttrEmail: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hidden_from_global_address_list": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 20),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EntityState](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ResourceType](),
+				Required:   true,
+			},
+		},
+	}
+}
+
+func (r *resourceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, name := data.OrganizationID.ValueString(), data.Name.ValueString()
+	var input workmail.CreateResourceInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateResource(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	id := aws.ToString(output.ResourceId)
+
+	// Booking options can only be set after the resource is created.
+	if !data.BookingOptions.IsUnknown() && !data.BookingOptions.IsNull() {
+		if err := updateResource(ctx, conn, &data, id); err != nil {
+			response.State.SetAttribute(ctx, path.Root("organization_id"), organizationID) // Set 'organization_id' and 'id' so as to taint.
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), id)
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	if email := data.Email.ValueString(); email != "" {
+		if err := registerToWorkMail(ctx, conn, organizationID, id, email); err != nil {
+			response.State.SetAttribute(ctx, path.Root("organization_id"), organizationID) // Set 'organization_id' and 'id' so as to taint.
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), id)
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	workmailResource, err := findResourceByTwoPartKey(ctx, conn, organizationID, id)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("organization_id"), organizationID) // Set 'organization_id' and 'id' so as to taint.
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id)
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, data.flatten(ctx, workmailResource))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *resourceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, id := data.OrganizationID.ValueString(), data.ID.ValueString()
+	output, err := findResourceByTwoPartKey(ctx, conn, organizationID, id)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, data.flatten(ctx, output))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *resourceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old resourceResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, id := new.OrganizationID.ValueString(), new.ID.ValueString()
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("Email"))
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		if err := updateResource(ctx, conn, &new, id); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	if !new.Email.IsUnknown() && !new.Email.Equal(old.Email) {
+		if err := updateEntityEmail(ctx, conn, organizationID, id, old.Email.ValueString(), new.Email.ValueString()); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	workmailResource, err := findResourceByTwoPartKey(ctx, conn, organizationID, id)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, new.flatten(ctx, workmailResource))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *resourceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, id := data.OrganizationID.ValueString(), data.ID.ValueString()
+
+	// Resources must be disabled before they can be deleted.
+	if data.State.ValueEnum() == awstypes.EntityStateEnabled {
+		if err := deregisterFromWorkMail(ctx, conn, organizationID, id); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	input := workmail.DeleteResourceInput{
+		OrganizationId: aws.String(organizationID),
+		ResourceId:     aws.String(id),
+	}
+	_, err := tfresource.RetryWhenIsA[any, *awstypes.EntityStateException](ctx, entityStateTimeout, func(ctx context.Context) (any, error) {
+		return conn.DeleteResource(ctx, &input)
+	})
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func (r *resourceResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(request.ID, entityResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), parts[1])...)
+}
+
+func updateResource(ctx context.Context, conn *workmail.Client, data *resourceResourceModel, id string) error {
+	var input workmail.UpdateResourceInput
+	if diags := fwflex.Expand(ctx, data, &input); diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	// Additional fields.
+	input.ResourceId = aws.String(id)
+
+	_, err := conn.UpdateResource(ctx, &input)
+
+	if err != nil {
+		return smarterr.NewError(err)
+	}
+
+	return nil
+}
+
+func findResourceByTwoPartKey(ctx context.Context, conn *workmail.Client, organizationID, resourceID string) (*workmail.DescribeResourceOutput, error) {
+	input := workmail.DescribeResourceInput{
+		OrganizationId: aws.String(organizationID),
+		ResourceId:     aws.String(resourceID),
+	}
+	output, err := conn.DescribeResource(ctx, &input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	if state := output.State; state == awstypes.EntityStateDeleted {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: string(state),
+		})
+	}
+
+	return output, nil
+}
+
+type resourceResourceModel struct {
+	framework.WithRegionModel
+	BookingOptions              fwtypes.ListNestedObjectValueOf[bookingOptionsModel] `tfsdk:"booking_options"`
+	Description                 types.String                                         `tfsdk:"description"`
+	Email                       types.String                                         `tfsdk:"email"`
+	HiddenFromGlobalAddressList types.Bool                                           `tfsdk:"hidden_from_global_address_list"`
+	ID                          types.String                                         `tfsdk:"id"`
+	Name                        types.String                                         `tfsdk:"name"`
+	OrganizationID              types.String                                         `tfsdk:"organization_id"`
+	State                       fwtypes.StringEnum[awstypes.EntityState]             `tfsdk:"state"`
+	Type                        fwtypes.StringEnum[awstypes.ResourceType]            `tfsdk:"type"`
+}
+
+func (m *resourceResourceModel) flatten(ctx context.Context, v *workmail.DescribeResourceOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, v, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.ID = fwflex.StringToFramework(ctx, v.ResourceId)
+
+	return diags
+}
+
+type bookingOptionsModel struct {
+	AutoAcceptRequests             types.Bool `tfsdk:"auto_accept_requests"`
+	AutoDeclineConflictingRequests types.Bool `tfsdk:"auto_decline_conflicting_requests"`
+	AutoDeclineRecurringRequests   types.Bool `tfsdk:"auto_decline_recurring_requests"`
+}
@@ -0,0 +1,505 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workmail_user", name="User")
+func newUserResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &userResource{}, nil
+}
+
+const (
+	entityResourceIDPartCount = 2
+
+	entityStateTimeout = 2 * time.Minute
+)
+
+type userResource struct {
+	framework.ResourceWithModel[userResourceModel]
+}
+
+func (r *userResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	optionalStringAttribute := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional: true,
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"city":       optionalStringAttribute(),
+			"company":    optionalStringAttribute(),
+			"country":    optionalStringAttribute(),
+			"department": optionalStringAttribute(),
+			names.AttrDisplayName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			names.AttrEmail: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"first_name": optionalStringAttribute(),
+			"hidden_from_global_address_list": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"initials":   optionalStringAttribute(),
+			"job_title":  optionalStringAttribute(),
+			"last_name":  optionalStringAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"office": optionalStringAttribute(),
+			"organization_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrPassword: schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			names.AttrRole: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.UserRole](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EntityState](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"street":    optionalStringAttribute(),
+			"telephone": optionalStringAttribute(),
+			"zip_code":  optionalStringAttribute(),
+		},
+	}
+}
+
+func (r *userResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data userResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, name := data.OrganizationID.ValueString(), data.Name.ValueString()
+	var input workmail.CreateUserInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateUser(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	id := aws.ToString(output.UserId)
+
+	// Contact details can only be set after the user is created.
+	if err := updateUser(ctx, conn, &data, id); err != nil {
+		response.State.SetAttribute(ctx, path.Root("organization_id"), organizationID) // Set 'organization_id' and 'id' so as to taint.
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id)
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	if email := data.Email.ValueString(); email != "" {
+		if err := registerToWorkMail(ctx, conn, organizationID, id, email); err != nil {
+			response.State.SetAttribute(ctx, path.Root("organization_id"), organizationID) // Set 'organization_id' and 'id' so as to taint.
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), id)
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	user, err := findUserByTwoPartKey(ctx, conn, organizationID, id)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("organization_id"), organizationID) // Set 'organization_id' and 'id' so as to taint.
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id)
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, data.flatten(ctx, user))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *userResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data userResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, id := data.OrganizationID.ValueString(), data.ID.ValueString()
+	output, err := findUserByTwoPartKey(ctx, conn, organizationID, id)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, data.flatten(ctx, output))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *userResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old userResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, id := new.OrganizationID.ValueString(), new.ID.ValueString()
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("Email"), fwflex.WithIgnoredField("Password"))
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		if err := updateUser(ctx, conn, &new, id, clearRemovedContactAttributes(&new, &old)); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	if !new.Password.Equal(old.Password) && !new.Password.IsNull() {
+		input := workmail.ResetPasswordInput{
+			OrganizationId: aws.String(organizationID),
+			Password:       fwflex.StringFromFramework(ctx, new.Password),
+			UserId:         aws.String(id),
+		}
+		_, err := conn.ResetPassword(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	if !new.Email.IsUnknown() && !new.Email.Equal(old.Email) {
+		if err := updateEntityEmail(ctx, conn, organizationID, id, old.Email.ValueString(), new.Email.ValueString()); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	user, err := findUserByTwoPartKey(ctx, conn, organizationID, id)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, new.flatten(ctx, user))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *userResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data userResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, id := data.OrganizationID.ValueString(), data.ID.ValueString()
+
+	// Users must be disabled before they can be deleted.
+	if data.State.ValueEnum() == awstypes.EntityStateEnabled {
+		if err := deregisterFromWorkMail(ctx, conn, organizationID, id); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	input := workmail.DeleteUserInput{
+		OrganizationId: aws.String(organizationID),
+		UserId:         aws.String(id),
+	}
+	_, err := tfresource.RetryWhenIsA[any, *awstypes.EntityStateException](ctx, entityStateTimeout, func(ctx context.Context) (any, error) {
+		return conn.DeleteUser(ctx, &input)
+	})
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func (r *userResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(request.ID, entityResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), parts[1])...)
+}
+
+func updateUser(ctx context.Context, conn *workmail.Client, data *userResourceModel, id string, optFns ...func(*workmail.UpdateUserInput)) error {
+	var input workmail.UpdateUserInput
+	if diags := fwflex.Expand(ctx, data, &input); diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	// Additional fields.
+	input.UserId = aws.String(id)
+
+	for _, fn := range optFns {
+		fn(&input)
+	}
+
+	_, err := conn.UpdateUser(ctx, &input)
+
+	if err != nil {
+		return smarterr.NewError(err)
+	}
+
+	return nil
+}
+
+// clearRemovedContactAttributes returns a function that sets contact attributes removed from configuration to an empty string,
+// as UpdateUser leaves attributes with a nil value unchanged.
+func clearRemovedContactAttributes(new, old *userResourceModel) func(*workmail.UpdateUserInput) {
+	return func(input *workmail.UpdateUserInput) {
+		for _, v := range []struct {
+			new, old types.String
+			field    **string
+		}{
+			{new.City, old.City, &input.City},
+			{new.Company, old.Company, &input.Company},
+			{new.Country, old.Country, &input.Country},
+			{new.Department, old.Department, &input.Department},
+			{new.FirstName, old.FirstName, &input.FirstName},
+			{new.Initials, old.Initials, &input.Initials},
+			{new.JobTitle, old.JobTitle, &input.JobTitle},
+			{new.LastName, old.LastName, &input.LastName},
+			{new.Office, old.Office, &input.Office},
+			{new.Street, old.Street, &input.Street},
+			{new.Telephone, old.Telephone, &input.Telephone},
+			{new.ZipCode, old.ZipCode, &input.ZipCode},
+		} {
+			if v.new.IsNull() && !v.old.IsNull() {
+				*v.field = aws.String("")
+			}
+		}
+	}
+}
+
+func findUserByTwoPartKey(ctx context.Context, conn *workmail.Client, organizationID, userID string) (*workmail.DescribeUserOutput, error) {
+	input := workmail.DescribeUserInput{
+		OrganizationId: aws.String(organizationID),
+		UserId:         aws.String(userID),
+	}
+	output, err := conn.DescribeUser(ctx, &input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	if state := output.State; state == awstypes.EntityStateDeleted {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: string(state),
+		})
+	}
+
+	return output, nil
+}
+
+// registerToWorkMail enables a user, group or resource and sets its primary email address.
+func registerToWorkMail(ctx context.Context, conn *workmail.Client, organizationID, entityID, email string) error {
+	input := workmail.RegisterToWorkMailInput{
+		Email:          aws.String(email),
+		EntityId:       aws.String(entityID),
+		OrganizationId: aws.String(organizationID),
+	}
+	_, err := conn.RegisterToWorkMail(ctx, &input)
+
+	if err != nil {
+		return smarterr.NewError(err)
+	}
+
+	return nil
+}
+
+// deregisterFromWorkMail disables a user, group or resource.
+func deregisterFromWorkMail(ctx context.Context, conn *workmail.Client, organizationID, entityID string) error {
+	input := workmail.DeregisterFromWorkMailInput{
+		EntityId:       aws.String(entityID),
+		OrganizationId: aws.String(organizationID),
+	}
+	_, err := conn.DeregisterFromWorkMail(ctx, &input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.EntityStateException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return smarterr.NewError(err)
+	}
+
+	return nil
+}
+
+// updateEntityEmail registers, re-addresses or deregisters a user, group or resource.
+func updateEntityEmail(ctx context.Context, conn *workmail.Client, organizationID, entityID, oldEmail, newEmail string) error {
+	switch {
+	case newEmail == "":
+		return deregisterFromWorkMail(ctx, conn, organizationID, entityID)
+	case oldEmail == "":
+		return registerToWorkMail(ctx, conn, organizationID, entityID, newEmail)
+	default:
+		input := workmail.UpdatePrimaryEmailAddressInput{
+			Email:          aws.String(newEmail),
+			EntityId:       aws.String(entityID),
+			OrganizationId: aws.String(organizationID),
+		}
+		_, err := conn.UpdatePrimaryEmailAddress(ctx, &input)
+
+		if err != nil {
+			return smarterr.NewError(err)
+		}
+
+		return nil
+	}
+}
+
+type userResourceModel struct {
+	framework.WithRegionModel
+	City                        types.String                             `tfsdk:"city" autoflex:",omitempty"`
+	Company                     types.String                             `tfsdk:"company" autoflex:",omitempty"`
+	Country                     types.String                             `tfsdk:"country" autoflex:",omitempty"`
+	Department                  types.String                             `tfsdk:"department" autoflex:",omitempty"`
+	DisplayName                 types.String                             `tfsdk:"display_name"`
+	Email                       types.String                             `tfsdk:"email"`
+	FirstName                   types.String                             `tfsdk:"first_name" autoflex:",omitempty"`
+	HiddenFromGlobalAddressList types.Bool                               `tfsdk:"hidden_from_global_address_list"`
+	ID                          types.String                             `tfsdk:"id"`
+	Initials                    types.String                             `tfsdk:"initials" autoflex:",omitempty"`
+	JobTitle                    types.String                             `tfsdk:"job_title" autoflex:",omitempty"`
+	LastName                    types.String                             `tfsdk:"last_name" autoflex:",omitempty"`
+	Name                        types.String                             `tfsdk:"name"`
+	Office                      types.String                             `tfsdk:"office" autoflex:",omitempty"`
+	OrganizationID              types.String                             `tfsdk:"organization_id"`
+	Password                    types.String                             `tfsdk:"password"`
+	Role                        fwtypes.StringEnum[awstypes.UserRole]    `tfsdk:"role"`
+	State                       fwtypes.StringEnum[awstypes.EntityState] `tfsdk:"state"`
+	Street                      types.String                             `tfsdk:"street" autoflex:",omitempty"`
+	Telephone                   types.String                             `tfsdk:"telephone" autoflex:",omitempty"`
+	ZipCode                     types.String                             `tfsdk:"zip_code" autoflex:",omitempty"`
+}
+
+func (m *userResourceModel) flatten(ctx context.Context, v *workmail.DescribeUserOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, v, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.ID = fwflex.StringToFramework(ctx, v.UserId)
+	m.Role = fwtypes.StringEnumValue(v.UserRole)
+
+	return diags
+}
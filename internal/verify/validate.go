@@ -33,6 +33,13 @@ var partitionRegexp = regexache.MustCompile(`^aws(-[a-z]+)*$`)
 // validates all listed in https://gist.github.com/shortjared/4c1e3fe52bdfa47522cfe5b41e5d6f22
 var servicePrincipalRegexp = regexache.MustCompile(`^([0-9a-z-]+\.){1,4}(amazonaws|amazon)\.com$`)
 
+// EC2 resource IDs have an 8 (legacy) or 17 character hexadecimal suffix.
+var (
+	SecurityGroupIDRegexp = regexache.MustCompile(`^sg-[0-9a-f]{8}([0-9a-f]{9})?$`)
+	SubnetIDRegexp        = regexache.MustCompile(`^subnet-[0-9a-f]{8}([0-9a-f]{9})?$`)
+	VPCIDRegexp           = regexache.MustCompile(`^vpc-[0-9a-f]{8}([0-9a-f]{9})?$`)
+)
+
 func StringIsInt32(v any, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -4,6 +4,7 @@
 package verify
 
 import (
+	"regexp"
 	"strings"
 	"testing"
 
@@ -621,6 +622,50 @@ func TestValidLaunchTemplateID(t *testing.T) {
 	}
 }
 
+func TestEC2ResourceIDRegexps(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		re      *regexp.Regexp
+		valid   []string
+		invalid []string
+	}{
+		{
+			name:    "security group",
+			re:      SecurityGroupIDRegexp,
+			valid:   []string{"sg-12345678", "sg-0123456789abcdef0"},
+			invalid: []string{"", "sg-1234567", "sg-0123456789abcdef", "sg-0123456789ABCDEF0", "subnet-12345678"},
+		},
+		{
+			name:    "subnet",
+			re:      SubnetIDRegexp,
+			valid:   []string{"subnet-12345678", "subnet-0123456789abcdef0"},
+			invalid: []string{"", "subnet-1234567", "subnet-0123456789abcdef", "subnet-0123456789ABCDEF0", "vpc-12345678"},
+		},
+		{
+			name:    "VPC",
+			re:      VPCIDRegexp,
+			valid:   []string{"vpc-12345678", "vpc-0123456789abcdef0"},
+			invalid: []string{"", "vpc-1234567", "vpc-0123456789abcdef", "vpc-0123456789ABCDEF0", "sg-12345678"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		for _, v := range testCase.valid {
+			if !testCase.re.MatchString(v) {
+				t.Fatalf("%q should be a valid %s ID", v, testCase.name)
+			}
+		}
+
+		for _, v := range testCase.invalid {
+			if testCase.re.MatchString(v) {
+				t.Fatalf("%q should be an invalid %s ID", v, testCase.name)
+			}
+		}
+	}
+}
+
 func TestValidUTCTimestamp(t *testing.T) {
 	t.Parallel()
 
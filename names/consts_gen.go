@@ -122,6 +122,7 @@ const (
 	Glue                         = "glue"
 	Grafana                      = "grafana"
 	Greengrass                   = "greengrass"
+	GreengrassV2                 = "greengrassv2"
 	GroundStation                = "groundstation"
 	GuardDuty                    = "guardduty"
 	HealthLake                   = "healthlake"
@@ -386,6 +387,7 @@ const (
 	GlueServiceID                         = "Glue"
 	GrafanaServiceID                      = "grafana"
 	GreengrassServiceID                   = "Greengrass"
+	GreengrassV2ServiceID                 = "GreengrassV2"
 	GroundStationServiceID                = "GroundStation"
 	GuardDutyServiceID                    = "GuardDuty"
 	HealthLakeServiceID                   = "HealthLake"
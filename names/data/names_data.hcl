@@ -4514,6 +4514,10 @@ service "greengrassv2" {
     human_friendly      = "IoT Greengrass V2"
   }
 
+  endpoint_info {
+    endpoint_api_call = "ListComponents"
+  }
+
   resource_prefix {
     correct = "aws_greengrassv2_"
   }
@@ -4521,7 +4525,6 @@ service "greengrassv2" {
   provider_package_correct = "greengrassv2"
   doc_prefix               = ["greengrassv2_"]
   brand                    = "AWS"
-  not_implemented          = true
 }
 
 service "iotjobsdata" {
@@ -4876,7 +4879,7 @@ service "kinesisvideo" {
   }
 
   provider_package_correct = "kinesisvideo"
-  doc_prefix               = ["kinesis_video_"]
+  doc_prefix               = ["kinesis_video_", "kinesisvideo_"]
   brand                    = "AWS"
 }
 